@@ -0,0 +1,303 @@
+// Package analysis determines error detection properties of CRC polynomials,
+// like the Hamming distance depending on the length of data words,
+// as published by Philip Koopman for many polynomials.
+//
+// An error pattern is not detected, if it is a codeword itself, i.e.
+// if the polynomial formed by its bits is a multiple of the generator polynomial.
+// Codewords are found using syndromes: the syndrome of bit position i
+// is x^i mod P, and a set of bit positions forms a codeword,
+// if their syndromes XOR to zero. As shifting a codeword results
+// in another codeword, it is sufficient to consider
+// codewords starting at position zero.
+//
+// Polynomials may be specified in any representation,
+// including Koopman's implicit +1 notation
+// using [crcutil.FromImplicit1Notation].
+package analysis
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/knieriem/crcutil"
+)
+
+// DefaultMaxWeight is the default value of Analyzer.MaxWeight.
+const DefaultMaxWeight = 8
+
+// Analyzer determines error detection properties of a polynomial.
+// Results are calculated incrementally; an Analyzer
+// keeps state to speed up subsequent queries.
+// It must not be used concurrently.
+type Analyzer struct {
+	// MaxWeight limits the weights of codewords searched for;
+	// Hamming distances larger than MaxWeight are reported as MaxWeight+1.
+	// The time needed to search for codewords of weight k grows
+	// with the (k-4)th power of the codeword length.
+	MaxWeight int
+
+	poly  uint64 // normal form, without the x^width term
+	width int
+	mask  uint64
+
+	syn    []uint64       // syndromes of positions 0..span-1
+	synPos map[uint64]int // syndromes of positions 1..span-2
+	pairs  map[uint64]struct{}
+
+	span      int         // codeword length explored so far
+	firstSpan map[int]int // minimum span of codewords by weight
+}
+
+// New returns an Analyzer for polynomial p, which must have the +1 term.
+func New[T crcutil.Word](p *crcutil.Poly[T]) *Analyzer {
+	n := p.NormalForm()
+	if n.Word&1 == 0 {
+		panic("analysis: polynomial lacks the +1 term")
+	}
+	a := &Analyzer{
+		MaxWeight: DefaultMaxWeight,
+		poly:      uint64(n.Word),
+		width:     n.Width,
+		mask:      ^uint64(0) >> (64 - n.Width),
+		synPos:    make(map[uint64]int),
+		firstSpan: make(map[int]int),
+	}
+	a.syn = append(a.syn, 1)
+	a.span = 1
+	return a
+}
+
+// Width returns the width of the polynomial,
+// which equals the number of check bits.
+func (a *Analyzer) Width() int {
+	return a.width
+}
+
+// mulX returns s·x mod P.
+func (a *Analyzer) mulX(s uint64) uint64 {
+	top := s >> (a.width - 1) & 1
+	s = s << 1 & a.mask
+	if top != 0 {
+		s ^= a.poly
+	}
+	return s
+}
+
+// maxHD returns the upper bound of reported Hamming distances.
+func (a *Analyzer) maxHD() int {
+	return a.MaxWeight + 1
+}
+
+// curHD returns the Hamming distance for codewords
+// of the length explored so far.
+func (a *Analyzer) curHD() int {
+	hd := a.maxHD()
+	for k, span := range a.firstSpan {
+		if span <= a.span && k < hd {
+			hd = k
+		}
+	}
+	return hd
+}
+
+// explore extends the search for codewords up to the
+// specified codeword length, or until a codeword of weight
+// less than stopHD is found.
+func (a *Analyzer) explore(n int, stopHD int) {
+	hd := a.curHD()
+	for a.span < n && hd >= stopHD && hd > 2 {
+		p := a.span
+		if p >= 2 {
+			a.synPos[a.syn[p-1]] = p - 1
+			if a.pairs != nil {
+				for b := 1; b < p-1; b++ {
+					a.pairs[a.syn[b]^a.syn[p-1]] = struct{}{}
+				}
+			}
+		}
+		a.syn = append(a.syn, a.mulX(a.syn[p-1]))
+		a.span++
+
+		if hd >= 6 && a.pairs == nil {
+			a.initPairs()
+		}
+		switch {
+		case p < a.width:
+			// codewords span at least width+1 bits
+		case p == a.width:
+			// the only codeword spanning width+1 bits is P itself
+			if k := bits.OnesCount64(a.poly) + 1; k < hd {
+				a.firstSpan[k] = p + 1
+				hd = k
+			}
+		default:
+			for k := 2; k < hd; k++ {
+				if a.hasCodeword(k, p) {
+					a.firstSpan[k] = p + 1
+					hd = k
+					break
+				}
+			}
+		}
+		if hd < 6 {
+			a.pairs = nil
+		}
+	}
+}
+
+func (a *Analyzer) initPairs() {
+	a.pairs = make(map[uint64]struct{})
+	for c := 2; c < a.span-1; c++ {
+		for b := 1; b < c; b++ {
+			a.pairs[a.syn[b]^a.syn[c]] = struct{}{}
+		}
+	}
+}
+
+// hasCodeword reports whether there is a codeword of weight k
+// with bits at positions 0 and p, and k-2 bits in between.
+// It relies on the absence of codewords of smaller weights.
+func (a *Analyzer) hasCodeword(k, p int) bool {
+	target := a.syn[0] ^ a.syn[p]
+	switch k {
+	case 2:
+		return target == 0
+	case 3:
+		_, ok := a.synPos[target]
+		return ok
+	case 4:
+		for b := 1; b < p; b++ {
+			if c, ok := a.synPos[target^a.syn[b]]; ok && c != b {
+				return true
+			}
+		}
+		return false
+	}
+	// enumerate k-4 positions, and look up the remaining pair
+	return a.hasCodewordRec(k-4, 1, p, target)
+}
+
+func (a *Analyzer) hasCodewordRec(n, from, p int, target uint64) bool {
+	if n == 0 {
+		_, ok := a.pairs[target]
+		return ok
+	}
+	for b := from; b < p; b++ {
+		if a.hasCodewordRec(n-1, b+1, p, target^a.syn[b]) {
+			return true
+		}
+	}
+	return false
+}
+
+// HD returns the Hamming distance for data words of n bits,
+// i.e. the minimum number of bit errors within a codeword
+// consisting of the data word and the checksum, that may remain undetected.
+// Hamming distances larger than MaxWeight are reported as MaxWeight+1.
+func (a *Analyzer) HD(n int) int {
+	cw := n + a.width
+	a.explore(cw, 0)
+	hd := a.maxHD()
+	for k, span := range a.firstSpan {
+		if span <= cw && k < hd {
+			hd = k
+		}
+	}
+	return hd
+}
+
+// MaxLen returns the maximum length of data words, in bits,
+// for which the Hamming distance is at least hd.
+// Lengths up to limit bits are examined;
+// if the Hamming distance is at least hd for data words
+// of limit bits, limit is returned, and ok is false.
+func (a *Analyzer) MaxLen(hd, limit int) (n int, ok bool) {
+	if hd > a.maxHD() {
+		panic("analysis: Hamming distance exceeds MaxWeight+1")
+	}
+	a.explore(limit+a.width, hd)
+	span := -1
+	for k, s := range a.firstSpan {
+		if k < hd && (span == -1 || s < span) {
+			span = s
+		}
+	}
+	if span == -1 || span-1-a.width >= limit {
+		return limit, false
+	}
+	return span - 1 - a.width, true
+}
+
+// Weights returns the number of codewords of weight k among
+// the codewords of data words of n bits, i.e. the number
+// of undetected error patterns of k bit errors.
+// Used with k equal to the Hamming distance, the result
+// corresponds to the HD weights listed by Koopman.
+// The time needed grows with the (k-2)th power of n.
+func (a *Analyzer) Weights(n, k int) uint64 {
+	cw := n + a.width
+	a.explore(cw, 0)
+	syn := a.syn
+	if len(syn) < cw {
+		// the search stopped early; calculate the remaining syndromes
+		syn = append([]uint64(nil), syn...)
+		for len(syn) < cw {
+			syn = append(syn, a.mulX(syn[len(syn)-1]))
+		}
+	}
+	if k < 2 {
+		return 0
+	}
+
+	// positions by syndrome, in ascending order
+	pos := make(map[uint64][]int)
+	var total uint64
+	for p := 1; p < cw; p++ {
+		if p >= 2 {
+			s := syn[p-1]
+			pos[s] = append(pos[s], p-1)
+		}
+		c := countSubsets(syn, pos, k-2, 1, p, syn[0]^syn[p])
+		total += c * uint64(cw-p)
+	}
+	return total
+}
+
+// countSubsets counts the subsets of n positions from..p-1,
+// the syndromes of which XOR to target.
+func countSubsets(syn []uint64, pos map[uint64][]int, n, from, p int, target uint64) uint64 {
+	switch n {
+	case 0:
+		if target == 0 {
+			return 1
+		}
+		return 0
+	case 1:
+		list := pos[target]
+		i := sort.SearchInts(list, from)
+		return uint64(len(list) - i)
+	}
+	var c uint64
+	for b := from; b < p; b++ {
+		c += countSubsets(syn, pos, n-1, b+1, p, target^syn[b])
+	}
+	return c
+}
+
+// UndetectedErrorProbability returns the probability that a codeword,
+// consisting of a data word of n bits and the checksum, is corrupted
+// by errors that remain undetected, if bits are corrupted independently
+// with the bit error rate ber. It is approximated by the contribution
+// of the error patterns of the smallest undetected weight, i.e. the
+// Hamming distance, which dominates as long as n·ber is small.
+// If the Hamming distance exceeds MaxWeight, zero is returned.
+func (a *Analyzer) UndetectedErrorProbability(n int, ber float64) float64 {
+	hd := a.HD(n)
+	if hd > a.MaxWeight {
+		return 0
+	}
+	w := a.Weights(n, hd)
+	cw := n + a.width
+	return float64(w) * math.Pow(ber, float64(hd)) * math.Pow(1-ber, float64(cw-hd))
+}
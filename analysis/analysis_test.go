@@ -0,0 +1,106 @@
+package analysis_test
+
+import (
+	"math"
+	"math/bits"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/analysis"
+)
+
+// bruteWeights returns the weight distribution of the codewords
+// of data words of n bits, by enumerating all data words.
+func bruteWeights(p *crcutil.Poly[uint8], n int) []uint64 {
+	w := p.Width
+	dist := make([]uint64, n+w+1)
+	for d := uint64(1); d < 1<<n; d++ {
+		// remainder of d·x^w mod P
+		r := uint64(0)
+		for i := n - 1; i >= 0; i-- {
+			r = uint64(crcutil.UpdateBitwise(p, uint8(r), uint8(d>>i&1), 1))
+		}
+		dist[bits.OnesCount64(d)+bits.OnesCount64(r)]++
+	}
+	return dist
+}
+
+func TestAnalysisBruteForce(t *testing.T) {
+	for _, k := range []uint8{0x83, 0x97, 0xA6, 0x9C, 0xEA} {
+		p := crcutil.FromImplicit1Notation(k)
+		a := analysis.New(p)
+		a.MaxWeight = 10
+		for n := 1; n <= 14; n++ {
+			dist := bruteWeights(p, n)
+			hd := 0
+			for i, c := range dist {
+				if c != 0 {
+					hd = i
+					break
+				}
+			}
+			if hd > a.MaxWeight {
+				hd = a.MaxWeight + 1
+			}
+			if got := a.HD(n); got != hd {
+				t.Fatalf("%#x, %d bits: HD %d, expected %d", k, n, got, hd)
+			}
+			for w := 2; w <= 5; w++ {
+				if got := a.Weights(n, w); got != dist[w] {
+					t.Fatalf("%#x, %d bits: %d codewords of weight %d, expected %d", k, n, got, w, dist[w])
+				}
+			}
+		}
+	}
+}
+
+func TestAnalysisKoopman(t *testing.T) {
+	// CRC-32 (IEEE 802.3), as listed in Koopman's tables
+	a := analysis.New(crcutil.FromImplicit1Notation[uint32](0x82608edb))
+	for _, tc := range []struct{ hd, maxLen int }{
+		{4, 91607},
+		{5, 2974},
+		{6, 268},
+		{7, 171},
+		{8, 91},
+	} {
+		n, ok := a.MaxLen(tc.hd, 100000)
+		if !ok || n != tc.maxLen {
+			t.Errorf("HD=%d: max length %d (%v), expected %d", tc.hd, n, ok, tc.maxLen)
+		}
+	}
+	if hd := a.HD(268); hd != 6 {
+		t.Errorf("HD at 268 bits: %d", hd)
+	}
+	if hd := a.HD(269); hd != 5 {
+		t.Errorf("HD at 269 bits: %d", hd)
+	}
+	if n, ok := a.MaxLen(3, 1000); ok || n != 1000 {
+		t.Errorf("HD=3: limit not reported: %d, %v", n, ok)
+	}
+
+	// CRC-8 0x07 (Koopman 0x83); HD=2 beyond the period of 127 bits
+	a = analysis.New(crcutil.FromImplicit1Notation[uint8](0x83))
+	if n, _ := a.MaxLen(3, 1000); n != 119 {
+		t.Errorf("CRC-8: HD=3 up to %d bits", n)
+	}
+	if hd := a.HD(120); hd != 2 {
+		t.Errorf("CRC-8: HD at 120 bits: %d", hd)
+	}
+}
+
+func TestUndetectedErrorProbability(t *testing.T) {
+	p := crcutil.FromImplicit1Notation[uint8](0x97)
+	a := analysis.New(p)
+	const n = 10
+	const ber = 1e-5
+	dist := bruteWeights(p, n)
+	exact := 0.0
+	for w, c := range dist {
+		exact += float64(c) * math.Pow(ber, float64(w)) * math.Pow(1-ber, float64(n+8-w))
+	}
+	got := a.UndetectedErrorProbability(n, ber)
+	if got == 0 || math.Abs(got-exact)/exact > 1e-3 {
+		t.Errorf("got %g, expected %g", got, exact)
+	}
+}
@@ -0,0 +1,243 @@
+package crcutil
+
+import (
+	"errors"
+	"sync"
+)
+
+// AnyModel is a non-generic variant of [Model], useful in cases
+// where the parameters of an algorithm are known only at runtime,
+// like when they are read from a configuration file.
+// Values are held in uint64 words; depending on Width,
+// a Model of a word type large enough is used internally.
+//
+// The fields correspond to the parameters of the Rocksoft™ model,
+// as used in the RevEng CRC catalogue: Poly is specified
+// in normal form, and Init is not reflected, even if RefIn is set.
+// Fields must not be modified once the AnyModel has been used.
+// Methods return the error reported by Validate,
+// if the parameters of the model are not valid.
+type AnyModel struct {
+	Name   string
+	Width  int
+	Poly   uint64
+	Init   uint64
+	RefIn  bool
+	RefOut bool
+	XorOut uint64
+
+	once  sync.Once
+	model anyModel
+	err   error
+}
+
+// NewAnyModel returns an AnyModel that uses the specified Model internally.
+func NewAnyModel[T Word](m *Model[T]) *AnyModel {
+	p := m.Poly.NormalForm()
+	init := m.initVal()
+	if m.reflectsIn() {
+		init = reverseBits(init, p.Width)
+	}
+	am := &AnyModel{
+		Width:  p.Width,
+		Poly:   uint64(p.Word),
+		Init:   uint64(init),
+		RefIn:  m.reflectsIn(),
+		RefOut: m.reflectsOut(),
+		XorOut: uint64(m.finalXOR(0)),
+	}
+	am.once.Do(func() {
+		am.model = modelOf[T]{m}
+	})
+	return am
+}
+
+// Validate reports an error if the parameters of the model are not valid.
+func (m *AnyModel) Validate() error {
+	if m.Width < 1 || m.Width > 64 {
+		return errors.New("crcutil: width out of range")
+	}
+	mask := ^uint64(0) >> (64 - m.Width)
+	switch {
+	case m.Poly&^mask != 0:
+		return errors.New("crcutil: polynomial exceeds width")
+	case m.Poly&1 == 0:
+		return errors.New("crcutil: polynomial lacks the +1 term")
+	case m.Init&^mask != 0:
+		return errors.New("crcutil: initial value exceeds width")
+	case m.XorOut&^mask != 0:
+		return errors.New("crcutil: final xor value exceeds width")
+	}
+	return nil
+}
+
+func (m *AnyModel) get() (anyModel, error) {
+	m.once.Do(func() {
+		if err := m.Validate(); err != nil {
+			m.err = err
+			return
+		}
+		switch {
+		case m.Width <= 8:
+			m.model = makeModelOf[uint8](m)
+		case m.Width <= 16:
+			m.model = makeModelOf[uint16](m)
+		case m.Width <= 32:
+			m.model = makeModelOf[uint32](m)
+		default:
+			m.model = makeModelOf[uint64](m)
+		}
+	})
+	return m.model, m.err
+}
+
+// New returns a new instance of the model.
+func (m *AnyModel) New(opts ...InstOption) (*AnyInst, error) {
+	am, err := m.get()
+	if err != nil {
+		return nil, err
+	}
+	return &AnyInst{inst: am.newInst(opts...)}, nil
+}
+
+// Checksum returns the CRC checksum calculated
+// over the bytes in the data slice.
+func (m *AnyModel) Checksum(data []byte) (uint64, error) {
+	am, err := m.get()
+	if err != nil {
+		return 0, err
+	}
+	return am.checksum(data), nil
+}
+
+// Combine returns the checksum of the concatenation of two blocks,
+// like [Model.Combine].
+func (m *AnyModel) Combine(crcA, crcB uint64, lenB int64) (uint64, error) {
+	am, err := m.get()
+	if err != nil {
+		return 0, err
+	}
+	return am.combine(crcA, crcB, lenB), nil
+}
+
+// Residue returns the residue of the model, like [Model.Residue].
+func (m *AnyModel) Residue() (uint64, error) {
+	am, err := m.get()
+	if err != nil {
+		return 0, err
+	}
+	return am.residue(), nil
+}
+
+// Verify reports whether frame ends with a valid checksum
+// of the preceding data, like [Model.Verify].
+func (m *AnyModel) Verify(frame []byte) (bool, error) {
+	am, err := m.get()
+	if err != nil {
+		return false, err
+	}
+	return am.verify(frame), nil
+}
+
+// AnyInst is an instance of an AnyModel.
+type AnyInst struct {
+	inst anyInst
+}
+
+// Reset sets the instance back to its initial state.
+func (inst *AnyInst) Reset() {
+	inst.inst.Reset()
+}
+
+// Write implements an io.Writer to add bytes to the crc.
+func (inst *AnyInst) Write(p []byte) (n int, err error) {
+	inst.inst.Update(p)
+	return len(p), nil
+}
+
+// Update adds bytes in p to the crc
+func (inst *AnyInst) Update(p []byte) {
+	inst.inst.Update(p)
+}
+
+// UpdateZeros advances the crc as if n zero bytes were added,
+// like [Inst.UpdateZeros].
+func (inst *AnyInst) UpdateZeros(n int64) {
+	inst.inst.UpdateZeros(n)
+}
+
+// Valid reports whether the bytes added so far form
+// a codeword, like [Inst.Valid].
+func (inst *AnyInst) Valid() bool {
+	return inst.inst.Valid()
+}
+
+// Sum returns the crc checksum; it does not change the current state.
+func (inst *AnyInst) Sum() uint64 {
+	return inst.inst.sum()
+}
+
+// AppendSum appends the crc checksum to the provided slice,
+// like [Inst.AppendSum].
+func (inst *AnyInst) AppendSum(in []byte) []byte {
+	return inst.inst.AppendSum(in)
+}
+
+type anyModel interface {
+	newInst(opts ...InstOption) anyInst
+	checksum(data []byte) uint64
+	residue() uint64
+	combine(crcA, crcB uint64, lenB int64) uint64
+	verify(frame []byte) bool
+}
+
+type anyInst interface {
+	Reset()
+	Update(p []byte)
+	UpdateZeros(n int64)
+	Valid() bool
+	AppendSum(in []byte) []byte
+	sum() uint64
+}
+
+type modelOf[T Word] struct {
+	*Model[T]
+}
+
+func makeModelOf[T Word](m *AnyModel) modelOf[T] {
+	return modelOf[T]{&Model[T]{
+		Poly:     &Poly[T]{Word: T(m.Poly), Width: m.Width},
+		Initial:  T(m.Init),
+		RefIn:    m.RefIn,
+		RefOut:   m.RefOut,
+		FinalXOR: T(m.XorOut),
+	}}
+}
+
+func (m modelOf[T]) newInst(opts ...InstOption) anyInst {
+	return instOf[T]{m.New(opts...)}
+}
+
+func (m modelOf[T]) checksum(data []byte) uint64 {
+	return uint64(m.Checksum(data))
+}
+
+func (m modelOf[T]) residue() uint64 {
+	return uint64(m.Model.Residue())
+}
+
+func (m modelOf[T]) combine(crcA, crcB uint64, lenB int64) uint64 {
+	return uint64(m.Combine(T(crcA), T(crcB), lenB))
+}
+
+func (m modelOf[T]) verify(frame []byte) bool {
+	return m.Verify(frame)
+}
+
+type instOf[T Word] struct {
+	*Inst[T]
+}
+
+func (inst instOf[T]) sum() uint64 {
+	return uint64(inst.Sum())
+}
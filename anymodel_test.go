@@ -0,0 +1,100 @@
+package crcutil_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc16"
+)
+
+func ExampleAnyModel() {
+	m := &crcutil.AnyModel{
+		Width:  12,
+		Poly:   0x80f,
+		RefOut: true,
+	}
+	inst, err := m.New()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	inst.Update([]byte(crcutil.CheckInput))
+	fmt.Printf("%#03x\n", inst.Sum())
+	// Output: 0xdaf
+}
+
+// TestAnyModelParams verifies that AnyModels created from the
+// parameters of all registered models yield the expected check values.
+func TestAnyModelParams(t *testing.T) {
+	for _, e := range crcutil.Registered() {
+		am := e.AnyModel()
+		m := &crcutil.AnyModel{
+			Width:  am.Width,
+			Poly:   am.Poly,
+			Init:   am.Init,
+			RefIn:  am.RefIn,
+			RefOut: am.RefOut,
+			XorOut: am.XorOut,
+		}
+		sum, err := m.Checksum([]byte(crcutil.CheckInput))
+		if err != nil {
+			t.Errorf("%s: %v", e.Name, err)
+			continue
+		}
+		if sum != e.Check {
+			t.Errorf("%s: check value mismatch: want %#x, got %#x", e.Name, e.Check, sum)
+		}
+	}
+}
+
+func TestAnyInst(t *testing.T) {
+	am := crcutil.NewAnyModel(crc16.Modbus)
+	if am.Poly != 0x8005 || am.Init != 0xffff || !am.RefIn || !am.RefOut || am.XorOut != 0 {
+		t.Fatalf("unexpected parameters: %+v", am)
+	}
+
+	frame := []byte{2, 7}
+	inst, err := am.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := crc16.Modbus.New()
+	for i := 0; i < 2; i++ {
+		inst.Update(frame)
+		ref.Update(frame)
+		if sum, want := inst.Sum(), uint64(ref.Sum()); sum != want {
+			t.Errorf("sum mismatch: want %#04x, got %#04x", want, sum)
+		}
+		if b, want := inst.AppendSum(nil), ref.AppendSum(nil); !bytes.Equal(b, want) {
+			t.Errorf("appended sum mismatch: want % x, got % x", want, b)
+		}
+		inst.Reset()
+		ref.Reset()
+	}
+}
+
+func TestAnyModelValidate(t *testing.T) {
+	for _, m := range []*crcutil.AnyModel{
+		{Width: 0, Poly: 1},
+		{Width: 65, Poly: 1},
+		{Width: 8, Poly: 0x107},
+		{Width: 8, Poly: 0x06},
+		{Width: 8, Poly: 0x07, Init: 0x100},
+		{Width: 8, Poly: 0x07, XorOut: 0x100},
+	} {
+		if err := m.Validate(); err == nil {
+			t.Errorf("invalid model passed validation: %+v", m)
+		}
+		if _, err := m.New(); err == nil {
+			t.Errorf("New: missing error: %+v", m)
+		}
+		if _, err := m.Checksum(checkData); err == nil {
+			t.Errorf("Checksum: missing error: %+v", m)
+		}
+		if _, err := m.Verify(checkData); err == nil {
+			t.Errorf("Verify: missing error: %+v", m)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package crcutil
+
+// CheckInput is the data over which the check value
+// of a catalogued algorithm is calculated.
+const CheckInput = "123456789"
+
+// A CatalogEntry describes a Model as listed in the
+// [Catalogue of parametrised CRC algorithms] of CRC RevEng.
+// Sub-packages crc{n} provide entries for the catalogued algorithms
+// of their bit widths in a variable Catalog.
+//
+// [Catalogue of parametrised CRC algorithms]: https://reveng.sourceforge.io/crc-catalogue/all.htm
+type CatalogEntry[T Word] struct {
+	// Name is the name of the algorithm as used in the catalogue,
+	// like "CRC-16/MODBUS".
+	Name string
+
+	// Aliases lists alternative names of the algorithm.
+	Aliases []string
+
+	Model *Model[T]
+
+	// Check is the checksum calculated over CheckInput.
+	Check T
+
+	// Residue is the content of the register after
+	// processing a valid codeword, i.e. data followed by its crc,
+	// and, if applicable, reflecting the register,
+	// but before the final XOR-ing is applied.
+	Residue T
+}
@@ -0,0 +1,31 @@
+// Package catalog registers all algorithms of the CRC RevEng
+// catalogue provided by the crc{n} sub-packages,
+// so that they can be looked up by name using [crcutil.Lookup].
+// It is intended to be imported for its side effects:
+//
+//	import _ "github.com/knieriem/crcutil/catalog"
+package catalog
+
+import (
+	_ "github.com/knieriem/crcutil/crc10"
+	_ "github.com/knieriem/crcutil/crc11"
+	_ "github.com/knieriem/crcutil/crc12"
+	_ "github.com/knieriem/crcutil/crc13"
+	_ "github.com/knieriem/crcutil/crc14"
+	_ "github.com/knieriem/crcutil/crc15"
+	_ "github.com/knieriem/crcutil/crc16"
+	_ "github.com/knieriem/crcutil/crc17"
+	_ "github.com/knieriem/crcutil/crc21"
+	_ "github.com/knieriem/crcutil/crc24"
+	_ "github.com/knieriem/crcutil/crc3"
+	_ "github.com/knieriem/crcutil/crc30"
+	_ "github.com/knieriem/crcutil/crc31"
+	_ "github.com/knieriem/crcutil/crc32"
+	_ "github.com/knieriem/crcutil/crc4"
+	_ "github.com/knieriem/crcutil/crc40"
+	_ "github.com/knieriem/crcutil/crc5"
+	_ "github.com/knieriem/crcutil/crc6"
+	_ "github.com/knieriem/crcutil/crc64"
+	_ "github.com/knieriem/crcutil/crc7"
+	_ "github.com/knieriem/crcutil/crc8"
+)
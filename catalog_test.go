@@ -0,0 +1,105 @@
+package crcutil_test
+
+import (
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc10"
+	"github.com/knieriem/crcutil/crc11"
+	"github.com/knieriem/crcutil/crc12"
+	"github.com/knieriem/crcutil/crc13"
+	"github.com/knieriem/crcutil/crc14"
+	"github.com/knieriem/crcutil/crc15"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/crc17"
+	"github.com/knieriem/crcutil/crc21"
+	"github.com/knieriem/crcutil/crc24"
+	crc3cat "github.com/knieriem/crcutil/crc3"
+	"github.com/knieriem/crcutil/crc30"
+	"github.com/knieriem/crcutil/crc31"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/crc4"
+	"github.com/knieriem/crcutil/crc40"
+	"github.com/knieriem/crcutil/crc5"
+	"github.com/knieriem/crcutil/crc6"
+	"github.com/knieriem/crcutil/crc64"
+	"github.com/knieriem/crcutil/crc7"
+	"github.com/knieriem/crcutil/crc8"
+)
+
+// TestCatalog verifies the check values and residues
+// of all entries of the predefined catalogues.
+func TestCatalog(t *testing.T) {
+	checkCatalog(t, crc3cat.Catalog)
+	checkCatalog(t, crc4.Catalog)
+	checkCatalog(t, crc5.Catalog)
+	checkCatalog(t, crc6.Catalog)
+	checkCatalog(t, crc7.Catalog)
+	checkCatalog(t, crc8.Catalog)
+	checkCatalog(t, crc10.Catalog)
+	checkCatalog(t, crc11.Catalog)
+	checkCatalog(t, crc12.Catalog)
+	checkCatalog(t, crc13.Catalog)
+	checkCatalog(t, crc14.Catalog)
+	checkCatalog(t, crc15.Catalog)
+	checkCatalog(t, crc16.Catalog)
+	checkCatalog(t, crc17.Catalog)
+	checkCatalog(t, crc21.Catalog)
+	checkCatalog(t, crc24.Catalog)
+	checkCatalog(t, crc30.Catalog)
+	checkCatalog(t, crc31.Catalog)
+	checkCatalog(t, crc32.Catalog)
+	checkCatalog(t, crc40.Catalog)
+	checkCatalog(t, crc64.Catalog)
+}
+
+func checkCatalog[T crcutil.Word](t *testing.T, cat []*crcutil.CatalogEntry[T]) {
+	for _, e := range cat {
+		e := e
+		t.Run(e.Name, func(t *testing.T) {
+			m := e.Model
+			if sum := m.Checksum([]byte(crcutil.CheckInput)); sum != e.Check {
+				t.Errorf("check value mismatch: want %#x, got %#x", e.Check, sum)
+			}
+			if r := residue(m); r != e.Residue {
+				t.Errorf("residue mismatch: want %#x, got %#x", e.Residue, r)
+			}
+			if r := m.Residue(); r != e.Residue {
+				t.Errorf("Model.Residue mismatch: want %#x, got %#x", e.Residue, r)
+			}
+		})
+	}
+}
+
+// residue calculates the residue of a model by processing
+// the final XOR value, in the orientation of the register,
+// bitwise through a register initialized to zero.
+func residue[T crcutil.Word](m *crcutil.Model[T]) T {
+	p := m.Poly.NormalForm()
+	mask := T(1)<<p.Width - 1
+	if p.Width == 64 {
+		mask = ^T(0)
+	}
+	x := m.FinalXOR
+	if m.FinalInvert {
+		x = mask
+	}
+	refOut := m.RefOut || m.Poly.Reversed
+	if refOut {
+		x = reflect(x, p.Width)
+	}
+	r := crcutil.UpdateBitwise(p, x, T(0), p.Width)
+	if refOut {
+		r = reflect(r, p.Width)
+	}
+	return r
+}
+
+func reflect[T crcutil.Word](v T, n int) T {
+	var r T
+	for i := 0; i < n; i++ {
+		r = r<<1 | v&1
+		v >>= 1
+	}
+	return r
+}
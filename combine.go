@@ -0,0 +1,77 @@
+package crcutil
+
+// Combine returns the checksum of the concatenation of two blocks A and B,
+// calculated from the checksum crcA of block A, the checksum crcB of
+// block B, and the length of block B in bytes.
+// This allows checksums of parts of some data to be calculated
+// independently, e.g. concurrently, and to be merged afterwards.
+//
+// Combine is based on the linearity of the crc calculation:
+// the register after processing A‖B equals the register after
+// processing A, extended by as many zero bits as B contains,
+// XOR-ed with the register after processing B starting with a zero register.
+// The extension is calculated as a multiplication with x^(8·lenB) modulo Poly.
+func (m *Model[T]) Combine(crcA, crcB T, lenB int64) T {
+	if lenB < 0 {
+		panic("crcutil: negative length")
+	}
+	g := newGF2(m.Poly)
+	init := m.normalReg(m.initVal())
+	a := m.sumToReg(crcA) ^ init
+	b := m.sumToReg(crcB)
+	return m.regToSum(g.mul(a, g.xPow(8, uint64(lenB))) ^ b)
+}
+
+// normalReg converts a register value from the representation
+// used while processing input into normal form.
+func (m *Model[T]) normalReg(crc T) uint64 {
+	if m.reflectsIn() {
+		crc = reverseBits(crc, m.Poly.Width)
+	}
+	return uint64(crc)
+}
+
+// procReg is the inverse of normalReg.
+func (m *Model[T]) procReg(r uint64) T {
+	crc := T(r)
+	if m.reflectsIn() {
+		crc = reverseBits(crc, m.Poly.Width)
+	}
+	return crc
+}
+
+// sumToReg returns the register value in normal form
+// that results in the specified checksum.
+func (m *Model[T]) sumToReg(sum T) uint64 {
+	return m.normalReg(m.reflectOut(m.finalXOR(sum)))
+}
+
+// regToSum returns the checksum resulting from
+// a register value in normal form.
+func (m *Model[T]) regToSum(r uint64) T {
+	return m.finalize(m.procReg(r))
+}
+
+// UpdateZeros advances the crc as if n zero bytes were added,
+// in a time proportional to the logarithm of n, by multiplying
+// the register with x^(8·n) modulo Poly.
+func (inst *Inst[T]) UpdateZeros(n int64) {
+	inst.updateZeros(8, n)
+}
+
+// UpdateZeroBits is like UpdateZeros, but advances the crc
+// by n zero bits.
+func (inst *Inst[T]) UpdateZeroBits(n int64) {
+	inst.updateZeros(1, n)
+}
+
+func (inst *Inst[T]) updateZeros(base int, n int64) {
+	if n < 0 {
+		panic("crcutil: negative count")
+	}
+	m := inst.model
+	g := newGF2(m.Poly)
+	r := m.normalReg(inst.adjustCRC(inst.crc))
+	r = g.mul(r, g.xPow(base, uint64(n)))
+	inst.crc = inst.adjustCRC(m.procReg(r))
+}
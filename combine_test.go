@@ -0,0 +1,154 @@
+package crcutil_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/poly16"
+)
+
+func ExampleModel_Combine() {
+	a := []byte("1234")
+	b := []byte("56789")
+	m := crc32.ISOHDLC
+	sum := m.Combine(m.Checksum(a), m.Checksum(b), int64(len(b)))
+	fmt.Printf("%#08x\n", sum)
+	// Output: 0xcbf43926
+}
+
+// TestCombine verifies, for all registered models, that
+// combining the checksums of two parts of some random data
+// results in the checksum of the whole data.
+func TestCombine(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 1000)
+	rnd.Read(data)
+
+	for _, e := range crcutil.Registered() {
+		m := e.AnyModel()
+		want := e.Checksum(data)
+		for _, split := range []int{0, 1, 7, 500, 999, 1000} {
+			a, b := data[:split], data[split:]
+			sum, err := m.Combine(e.Checksum(a), e.Checksum(b), int64(len(b)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sum != want {
+				t.Errorf("%s: split at %d: want %#x, got %#x", e.Name, split, want, sum)
+			}
+		}
+	}
+}
+
+// TestCombineReciprocal verifies Combine for models
+// using a polynomial in reciprocal form.
+func TestCombineReciprocal(t *testing.T) {
+	data := []byte(crcutil.CheckInput)
+	for _, tc := range []struct {
+		name string
+		poly *crcutil.Poly[uint16]
+	}{
+		{"reciprocal", poly16.CCITT.ReciprocalForm()},
+		{"reversed reciprocal", poly16.CCITT.ReversedForm().ReciprocalForm()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &crcutil.Model[uint16]{Poly: tc.poly, InitialInvert: true, FinalInvert: true}
+			want := m.Checksum(data)
+			for _, split := range []int{0, 4, len(data)} {
+				a, b := data[:split], data[split:]
+				sum := m.Combine(m.Checksum(a), m.Checksum(b), int64(len(b)))
+				if sum != want {
+					t.Errorf("split at %d: want %#x, got %#x", split, want, sum)
+				}
+			}
+		})
+	}
+}
+
+// TestUpdateZeros compares, for all registered models,
+// the results of UpdateZeros against updating
+// a buffer of zero bytes.
+func TestUpdateZeros(t *testing.T) {
+	zeros := make([]byte, 1000)
+	for _, e := range crcutil.Registered() {
+		m := e.AnyModel()
+		for _, n := range []int{0, 1, 3, 100, 1000} {
+			inst, err := m.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			inst.Update([]byte(crcutil.CheckInput))
+			inst.UpdateZeros(int64(n))
+			want := e.Checksum(append([]byte(crcutil.CheckInput), zeros[:n]...))
+			if sum := inst.Sum(); sum != want {
+				t.Errorf("%s: %d zeros: want %#x, got %#x", e.Name, n, want, sum)
+			}
+		}
+	}
+}
+
+// TestUpdateZerosReciprocal compares results of UpdateZeros against
+// updating a buffer of zero bytes for models using a polynomial
+// in reciprocal form.
+func TestUpdateZerosReciprocal(t *testing.T) {
+	zeros := make([]byte, 100)
+	for _, tc := range []struct {
+		name string
+		poly *crcutil.Poly[uint16]
+	}{
+		{"reciprocal", poly16.CCITT.ReciprocalForm()},
+		{"reversed reciprocal", poly16.CCITT.ReversedForm().ReciprocalForm()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &crcutil.Model[uint16]{Poly: tc.poly, InitialInvert: true, FinalInvert: true}
+			for _, n := range []int{1, 5, 100} {
+				inst := m.New()
+				inst.Update([]byte(crcutil.CheckInput))
+				inst.UpdateZeros(int64(n))
+				want := m.Checksum(append([]byte(crcutil.CheckInput), zeros[:n]...))
+				if sum := inst.Sum(); sum != want {
+					t.Errorf("%d zeros: want %#x, got %#x", n, want, sum)
+				}
+			}
+		})
+	}
+}
+
+// TestUpdateZeroBits compares results of UpdateZeroBits against
+// bitwise updates, using models without initial value and final XOR-ing,
+// so that the checksum equals the register.
+func TestUpdateZeroBits(t *testing.T) {
+	t.Run("normal", checkUpdateZeroBits(poly16.CCITT))
+	t.Run("reversed", checkUpdateZeroBits(poly16.CCITT.ReversedForm()))
+	t.Run("reciprocal", checkUpdateZeroBits(poly16.CCITT.ReciprocalForm()))
+	t.Run("reversed reciprocal", checkUpdateZeroBits(poly16.CCITT.ReversedForm().ReciprocalForm()))
+	t.Run("narrow", checkUpdateZeroBits(&crcutil.Poly[uint32]{Word: 0x864cfb, Width: 24}))
+	t.Run("narrow reversed", checkUpdateZeroBits((&crcutil.Poly[uint8]{Word: 0x05, Width: 5}).ReversedForm()))
+}
+
+func checkUpdateZeroBits[T crcutil.Word](p *crcutil.Poly[T]) func(t *testing.T) {
+	return func(t *testing.T) {
+		m := &crcutil.Model[T]{Poly: p}
+		for _, n := range []int{1, 3, 7} {
+			inst := m.New()
+			inst.Update([]byte(crcutil.CheckInput))
+			want := crcutil.UpdateBitwise(p, inst.Sum(), T(0), n)
+			inst.UpdateZeroBits(int64(n))
+			if sum := inst.Sum(); sum != want {
+				t.Errorf("%d zero bits: want %#x, got %#x", n, want, sum)
+			}
+		}
+
+		inst := m.New()
+		inst.UpdateZeroBits(3)
+		inst.UpdateZeroBits(13)
+		ref := m.New()
+		ref.UpdateZeros(2)
+		if sum, want := inst.Sum(), ref.Sum(); sum != want {
+			t.Errorf("16 zero bits: want %#x, got %#x", want, sum)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package crc10
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/poly10"
+)
+
+var (
+	// CRC-10/ATM, alias CRC-10, CRC-10/I-610
+	ATM = &crc16.Model{
+		Poly: poly10.New(0x233),
+	}
+
+	// CRC-10/CDMA2000
+	CDMA2000 = &crc16.Model{
+		Poly:          poly10.New(0x3d9),
+		InitialInvert: true,
+	}
+
+	// CRC-10/GSM
+	GSM = &crc16.Model{
+		Poly:        poly10.New(0x175),
+		FinalInvert: true,
+	}
+)
+
+// Catalog lists the 10-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc16.CatalogEntry{
+	{
+		Name:    "CRC-10/ATM",
+		Aliases: []string{"CRC-10", "CRC-10/I-610"},
+		Model:   ATM,
+		Check:   0x199,
+		Residue: 0x000,
+	},
+	{
+		Name:    "CRC-10/CDMA2000",
+		Model:   CDMA2000,
+		Check:   0x233,
+		Residue: 0x000,
+	},
+	{
+		Name:    "CRC-10/GSM",
+		Model:   GSM,
+		Check:   0x12a,
+		Residue: 0x0c6,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package crc11
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/poly11"
+)
+
+var (
+	// CRC-11/FLEXRAY, alias CRC-11
+	FlexRay = &crc16.Model{
+		Poly:    poly11.New(0x385),
+		Initial: 0x01a,
+	}
+
+	// CRC-11/UMTS
+	UMTS = &crc16.Model{
+		Poly: poly11.New(0x307),
+	}
+)
+
+// Catalog lists the 11-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc16.CatalogEntry{
+	{
+		Name:    "CRC-11/FLEXRAY",
+		Aliases: []string{"CRC-11"},
+		Model:   FlexRay,
+		Check:   0x5a3,
+		Residue: 0x000,
+	},
+	{
+		Name:    "CRC-11/UMTS",
+		Model:   UMTS,
+		Check:   0x061,
+		Residue: 0x000,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package crc12
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/poly12"
+)
+
+var (
+	// CRC-12/CDMA2000
+	CDMA2000 = &crc16.Model{
+		Poly:          poly12.New(0xf13),
+		InitialInvert: true,
+	}
+
+	// CRC-12/DECT, alias X-CRC-12
+	DECT = &crc16.Model{
+		Poly: poly12.New(0x80f),
+	}
+
+	// CRC-12/GSM
+	GSM = &crc16.Model{
+		Poly:        poly12.New(0xd31),
+		FinalInvert: true,
+	}
+
+	// CRC-12/UMTS, alias CRC-12/3GPP
+	UMTS = &crc16.Model{
+		Poly:   poly12.New(0x80f),
+		RefOut: true,
+	}
+)
+
+// Catalog lists the 12-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc16.CatalogEntry{
+	{
+		Name:    "CRC-12/CDMA2000",
+		Model:   CDMA2000,
+		Check:   0xd4d,
+		Residue: 0x000,
+	},
+	{
+		Name:    "CRC-12/DECT",
+		Aliases: []string{"X-CRC-12"},
+		Model:   DECT,
+		Check:   0xf5b,
+		Residue: 0x000,
+	},
+	{
+		Name:    "CRC-12/GSM",
+		Model:   GSM,
+		Check:   0xb34,
+		Residue: 0x178,
+	},
+	{
+		Name:    "CRC-12/UMTS",
+		Aliases: []string{"CRC-12/3GPP"},
+		Model:   UMTS,
+		Check:   0xdaf,
+		Residue: 0x000,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
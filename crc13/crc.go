@@ -0,0 +1,32 @@
+package crc13
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/poly13"
+)
+
+var (
+	// CRC-13/BBC
+	BBC = &crc16.Model{
+		Poly: poly13.New(0x1cf5),
+	}
+)
+
+// Catalog lists the 13-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc16.CatalogEntry{
+	{
+		Name:    "CRC-13/BBC",
+		Model:   BBC,
+		Check:   0x04fa,
+		Residue: 0x0000,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
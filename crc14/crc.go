@@ -0,0 +1,46 @@
+package crc14
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/poly14"
+)
+
+var (
+	// CRC-14/DARC
+	DARC = &crc16.Model{
+		Poly:   poly14.New(0x0805),
+		RefIn:  true,
+		RefOut: true,
+	}
+
+	// CRC-14/GSM
+	GSM = &crc16.Model{
+		Poly:        poly14.New(0x202d),
+		FinalInvert: true,
+	}
+)
+
+// Catalog lists the 14-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc16.CatalogEntry{
+	{
+		Name:    "CRC-14/DARC",
+		Model:   DARC,
+		Check:   0x082d,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-14/GSM",
+		Model:   GSM,
+		Check:   0x30ae,
+		Residue: 0x031e,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
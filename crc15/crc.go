@@ -0,0 +1,45 @@
+package crc15
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/poly15"
+)
+
+var (
+	// CRC-15/CAN, alias CRC-15
+	CAN = &crc16.Model{
+		Poly: poly15.New(0x4599),
+	}
+
+	// CRC-15/MPT1327
+	MPT1327 = &crc16.Model{
+		Poly:     poly15.New(0x6815),
+		FinalXOR: 0x0001,
+	}
+)
+
+// Catalog lists the 15-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc16.CatalogEntry{
+	{
+		Name:    "CRC-15/CAN",
+		Aliases: []string{"CRC-15"},
+		Model:   CAN,
+		Check:   0x059e,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-15/MPT1327",
+		Model:   MPT1327,
+		Check:   0x2566,
+		Residue: 0x6815,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
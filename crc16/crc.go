@@ -8,9 +8,420 @@ import (
 type Model = crcutil.Model[uint16]
 type Inst = crcutil.Inst[uint16]
 
+type CatalogEntry = crcutil.CatalogEntry[uint16]
+
 var (
+	// CRC-16/ARC, alias ARC, CRC-16, CRC-16/LHA, CRC-IBM
+	ARC = &Model{
+		Poly:   poly16.IBM,
+		RefIn:  true,
+		RefOut: true,
+	}
+
+	// CRC-16/CDMA2000
+	CDMA2000 = &Model{
+		Poly:          poly16.New(0xc867),
+		InitialInvert: true,
+	}
+
+	// CRC-16/CMS
+	CMS = &Model{
+		Poly:          poly16.IBM,
+		InitialInvert: true,
+	}
+
+	// CRC-16/DDS-110
+	DDS110 = &Model{
+		Poly:    poly16.IBM,
+		Initial: 0x800d,
+	}
+
+	// CRC-16/DECT-R, alias R-CRC-16
+	DECTR = &Model{
+		Poly:     poly16.New(0x0589),
+		FinalXOR: 0x0001,
+	}
+
+	// CRC-16/DECT-X, alias X-CRC-16
+	DECTX = &Model{
+		Poly: poly16.New(0x0589),
+	}
+
+	// CRC-16/DNP
+	DNP = &Model{
+		Poly:        poly16.New(0x3d65),
+		RefIn:       true,
+		RefOut:      true,
+		FinalInvert: true,
+	}
+
+	// CRC-16/EN-13757
+	EN13757 = &Model{
+		Poly:        poly16.New(0x3d65),
+		FinalInvert: true,
+	}
+
+	// CRC-16/GENIBUS, alias CRC-16/DARC, CRC-16/EPC, CRC-16/EPC-C1G2, CRC-16/I-CODE
+	Genibus = &Model{
+		Poly:          poly16.CCITT,
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+
+	// CRC-16/GSM
+	GSM = &Model{
+		Poly:        poly16.CCITT,
+		FinalInvert: true,
+	}
+
+	// CRC-16/IBM-3740, alias CRC-16/AUTOSAR, CRC-16/CCITT-FALSE
+	IBM3740 = &Model{
+		Poly:          poly16.CCITT,
+		InitialInvert: true,
+	}
+
+	// CRC-16/IBM-SDLC, alias CRC-16/ISO-HDLC, CRC-16/ISO-IEC-14443-3-B, CRC-16/X-25, CRC-B, X-25
+	IBMSDLC = &Model{
+		Poly:          poly16.CCITT,
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+		FinalInvert:   true,
+	}
+
+	// CRC-16/ISO-IEC-14443-3-A, alias CRC-A
+	ISOIEC144433A = &Model{
+		Poly:    poly16.CCITT,
+		Initial: 0xc6c6,
+		RefIn:   true,
+		RefOut:  true,
+	}
+
+	// CRC-16/KERMIT, alias CRC-16/BLUETOOTH, CRC-16/CCITT, CRC-16/CCITT-TRUE, CRC-16/V-41-LSB, CRC-CCITT, KERMIT
+	Kermit = &Model{
+		Poly:   poly16.CCITT,
+		RefIn:  true,
+		RefOut: true,
+	}
+
+	// CRC-16/LJ1200
+	LJ1200 = &Model{
+		Poly: poly16.New(0x6f63),
+	}
+
+	// CRC-16/M17
+	M17 = &Model{
+		Poly:          poly16.New(0x5935),
+		InitialInvert: true,
+	}
+
+	// CRC-16/MAXIM-DOW, alias CRC-16/MAXIM
+	MaximDOW = &Model{
+		Poly:        poly16.IBM,
+		RefIn:       true,
+		RefOut:      true,
+		FinalInvert: true,
+	}
+
+	// CRC-16/MCRF4XX
+	MCRF4XX = &Model{
+		Poly:          poly16.CCITT,
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+	}
+
+	// CRC-16/MODBUS, alias MODBUS
 	Modbus = &Model{
 		Poly:          poly16.IBM.ReversedForm(),
 		InitialInvert: true,
 	}
+
+	// CRC-16/NRSC-5
+	NRSC5 = &Model{
+		Poly:          poly16.New(0x080b),
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+	}
+
+	// CRC-16/OPENSAFETY-A
+	OpenSafetyA = &Model{
+		Poly: poly16.New(0x5935),
+	}
+
+	// CRC-16/OPENSAFETY-B
+	OpenSafetyB = &Model{
+		Poly: poly16.New(0x755b),
+	}
+
+	// CRC-16/PROFIBUS, alias CRC-16/IEC-61158-2
+	Profibus = &Model{
+		Poly:          poly16.New(0x1dcf),
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+
+	// CRC-16/RIELLO
+	Riello = &Model{
+		Poly:    poly16.CCITT,
+		Initial: 0xb2aa,
+		RefIn:   true,
+		RefOut:  true,
+	}
+
+	// CRC-16/SPI-FUJITSU, alias CRC-16/AUG-CCITT
+	SPIFujitsu = &Model{
+		Poly:    poly16.CCITT,
+		Initial: 0x1d0f,
+	}
+
+	// CRC-16/T10-DIF
+	T10DIF = &Model{
+		Poly: poly16.New(0x8bb7),
+	}
+
+	// CRC-16/TELEDISK
+	Teledisk = &Model{
+		Poly: poly16.New(0xa097),
+	}
+
+	// CRC-16/TMS37157
+	TMS37157 = &Model{
+		Poly:    poly16.CCITT,
+		Initial: 0x89ec,
+		RefIn:   true,
+		RefOut:  true,
+	}
+
+	// CRC-16/UMTS, alias CRC-16/BUYPASS, CRC-16/VERIFONE
+	UMTS = &Model{
+		Poly: poly16.IBM,
+	}
+
+	// CRC-16/USB
+	USB = &Model{
+		Poly:          poly16.IBM,
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+		FinalInvert:   true,
+	}
+
+	// CRC-16/XMODEM, alias CRC-16/ACORN, CRC-16/LTE, CRC-16/V-41-MSB, XMODEM, ZMODEM
+	XMODEM = &Model{
+		Poly: poly16.CCITT,
+	}
 )
+
+// Catalog lists the 16-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*CatalogEntry{
+	{
+		Name:    "CRC-16/ARC",
+		Aliases: []string{"ARC", "CRC-16", "CRC-16/LHA", "CRC-IBM"},
+		Model:   ARC,
+		Check:   0xbb3d,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/CDMA2000",
+		Model:   CDMA2000,
+		Check:   0x4c06,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/CMS",
+		Model:   CMS,
+		Check:   0xaee7,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/DDS-110",
+		Model:   DDS110,
+		Check:   0x9ecf,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/DECT-R",
+		Aliases: []string{"R-CRC-16"},
+		Model:   DECTR,
+		Check:   0x007e,
+		Residue: 0x0589,
+	},
+	{
+		Name:    "CRC-16/DECT-X",
+		Aliases: []string{"X-CRC-16"},
+		Model:   DECTX,
+		Check:   0x007f,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/DNP",
+		Model:   DNP,
+		Check:   0xea82,
+		Residue: 0x66c5,
+	},
+	{
+		Name:    "CRC-16/EN-13757",
+		Model:   EN13757,
+		Check:   0xc2b7,
+		Residue: 0xa366,
+	},
+	{
+		Name:    "CRC-16/GENIBUS",
+		Aliases: []string{"CRC-16/DARC", "CRC-16/EPC", "CRC-16/EPC-C1G2", "CRC-16/I-CODE"},
+		Model:   Genibus,
+		Check:   0xd64e,
+		Residue: 0x1d0f,
+	},
+	{
+		Name:    "CRC-16/GSM",
+		Model:   GSM,
+		Check:   0xce3c,
+		Residue: 0x1d0f,
+	},
+	{
+		Name:    "CRC-16/IBM-3740",
+		Aliases: []string{"CRC-16/AUTOSAR", "CRC-16/CCITT-FALSE"},
+		Model:   IBM3740,
+		Check:   0x29b1,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/IBM-SDLC",
+		Aliases: []string{"CRC-16/ISO-HDLC", "CRC-16/ISO-IEC-14443-3-B", "CRC-16/X-25", "CRC-B", "X-25"},
+		Model:   IBMSDLC,
+		Check:   0x906e,
+		Residue: 0xf0b8,
+	},
+	{
+		Name:    "CRC-16/ISO-IEC-14443-3-A",
+		Aliases: []string{"CRC-A"},
+		Model:   ISOIEC144433A,
+		Check:   0xbf05,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/KERMIT",
+		Aliases: []string{"CRC-16/BLUETOOTH", "CRC-16/CCITT", "CRC-16/CCITT-TRUE", "CRC-16/V-41-LSB", "CRC-CCITT", "KERMIT"},
+		Model:   Kermit,
+		Check:   0x2189,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/LJ1200",
+		Model:   LJ1200,
+		Check:   0xbdf4,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/M17",
+		Model:   M17,
+		Check:   0x772b,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/MAXIM-DOW",
+		Aliases: []string{"CRC-16/MAXIM"},
+		Model:   MaximDOW,
+		Check:   0x44c2,
+		Residue: 0xb001,
+	},
+	{
+		Name:    "CRC-16/MCRF4XX",
+		Model:   MCRF4XX,
+		Check:   0x6f91,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/MODBUS",
+		Aliases: []string{"MODBUS"},
+		Model:   Modbus,
+		Check:   0x4b37,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/NRSC-5",
+		Model:   NRSC5,
+		Check:   0xa066,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/OPENSAFETY-A",
+		Model:   OpenSafetyA,
+		Check:   0x5d38,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/OPENSAFETY-B",
+		Model:   OpenSafetyB,
+		Check:   0x20fe,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/PROFIBUS",
+		Aliases: []string{"CRC-16/IEC-61158-2"},
+		Model:   Profibus,
+		Check:   0xa819,
+		Residue: 0xe394,
+	},
+	{
+		Name:    "CRC-16/RIELLO",
+		Model:   Riello,
+		Check:   0x63d0,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/SPI-FUJITSU",
+		Aliases: []string{"CRC-16/AUG-CCITT"},
+		Model:   SPIFujitsu,
+		Check:   0xe5cc,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/T10-DIF",
+		Model:   T10DIF,
+		Check:   0xd0db,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/TELEDISK",
+		Model:   Teledisk,
+		Check:   0x0fb3,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/TMS37157",
+		Model:   TMS37157,
+		Check:   0x26b1,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/UMTS",
+		Aliases: []string{"CRC-16/BUYPASS", "CRC-16/VERIFONE"},
+		Model:   UMTS,
+		Check:   0xfee8,
+		Residue: 0x0000,
+	},
+	{
+		Name:    "CRC-16/USB",
+		Model:   USB,
+		Check:   0xb4c8,
+		Residue: 0xb001,
+	},
+	{
+		Name:    "CRC-16/XMODEM",
+		Aliases: []string{"CRC-16/ACORN", "CRC-16/LTE", "CRC-16/V-41-MSB", "XMODEM", "ZMODEM"},
+		Model:   XMODEM,
+		Check:   0x31c3,
+		Residue: 0x0000,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package crc17
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/poly17"
+)
+
+var (
+	// CRC-17/CAN-FD
+	CANFD = &crc32.Model{
+		Poly: poly17.New(0x1685b),
+	}
+)
+
+// Catalog lists the 17-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc32.CatalogEntry{
+	{
+		Name:    "CRC-17/CAN-FD",
+		Model:   CANFD,
+		Check:   0x04f03,
+		Residue: 0x00000,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
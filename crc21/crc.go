@@ -0,0 +1,32 @@
+package crc21
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/poly21"
+)
+
+var (
+	// CRC-21/CAN-FD
+	CANFD = &crc32.Model{
+		Poly: poly21.New(0x102899),
+	}
+)
+
+// Catalog lists the 21-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc32.CatalogEntry{
+	{
+		Name:    "CRC-21/CAN-FD",
+		Model:   CANFD,
+		Check:   0x0ed841,
+		Residue: 0x000000,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package crc24
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/poly24"
+)
+
+var (
+	// CRC-24/BLE
+	BLE = &crc32.Model{
+		Poly:    poly24.New(0x00065b),
+		Initial: 0x555555,
+		RefIn:   true,
+		RefOut:  true,
+	}
+
+	// CRC-24/FLEXRAY-A
+	FlexRayA = &crc32.Model{
+		Poly:    poly24.New(0x5d6dcb),
+		Initial: 0xfedcba,
+	}
+
+	// CRC-24/FLEXRAY-B
+	FlexRayB = &crc32.Model{
+		Poly:    poly24.New(0x5d6dcb),
+		Initial: 0xabcdef,
+	}
+
+	// CRC-24/INTERLAKEN
+	Interlaken = &crc32.Model{
+		Poly:          poly24.New(0x328b63),
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+
+	// CRC-24/LTE-A
+	LTEA = &crc32.Model{
+		Poly: poly24.New(0x864cfb),
+	}
+
+	// CRC-24/LTE-B
+	LTEB = &crc32.Model{
+		Poly: poly24.New(0x800063),
+	}
+
+	// CRC-24/OPENPGP, alias CRC-24
+	OpenPGP = &crc32.Model{
+		Poly:    poly24.New(0x864cfb),
+		Initial: 0xb704ce,
+	}
+
+	// CRC-24/OS-9
+	OS9 = &crc32.Model{
+		Poly:          poly24.New(0x800063),
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+)
+
+// Catalog lists the 24-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc32.CatalogEntry{
+	{
+		Name:    "CRC-24/BLE",
+		Model:   BLE,
+		Check:   0xc25a56,
+		Residue: 0x000000,
+	},
+	{
+		Name:    "CRC-24/FLEXRAY-A",
+		Model:   FlexRayA,
+		Check:   0x7979bd,
+		Residue: 0x000000,
+	},
+	{
+		Name:    "CRC-24/FLEXRAY-B",
+		Model:   FlexRayB,
+		Check:   0x1f23b8,
+		Residue: 0x000000,
+	},
+	{
+		Name:    "CRC-24/INTERLAKEN",
+		Model:   Interlaken,
+		Check:   0xb4f3e6,
+		Residue: 0x144e63,
+	},
+	{
+		Name:    "CRC-24/LTE-A",
+		Model:   LTEA,
+		Check:   0xcde703,
+		Residue: 0x000000,
+	},
+	{
+		Name:    "CRC-24/LTE-B",
+		Model:   LTEB,
+		Check:   0x23ef52,
+		Residue: 0x000000,
+	},
+	{
+		Name:    "CRC-24/OPENPGP",
+		Aliases: []string{"CRC-24"},
+		Model:   OpenPGP,
+		Check:   0x21cf02,
+		Residue: 0x000000,
+	},
+	{
+		Name:    "CRC-24/OS-9",
+		Model:   OS9,
+		Check:   0x200fa5,
+		Residue: 0x800fe3,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package crc3
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc8"
+	"github.com/knieriem/crcutil/poly3"
+)
+
+var (
+	// CRC-3/GSM
+	GSM = &crc8.Model{
+		Poly:        poly3.GSM,
+		FinalInvert: true,
+	}
+
+	// CRC-3/ROHC
+	ROHC = &crc8.Model{
+		Poly:          poly3.GSM,
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+	}
+)
+
+// Catalog lists the 3-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc8.CatalogEntry{
+	{
+		Name:    "CRC-3/GSM",
+		Model:   GSM,
+		Check:   0x4,
+		Residue: 0x2,
+	},
+	{
+		Name:    "CRC-3/ROHC",
+		Model:   ROHC,
+		Check:   0x6,
+		Residue: 0x0,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package crc30
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/poly30"
+)
+
+var (
+	// CRC-30/CDMA
+	CDMA = &crc32.Model{
+		Poly:          poly30.New(0x2030b9c7),
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+)
+
+// Catalog lists the 30-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc32.CatalogEntry{
+	{
+		Name:    "CRC-30/CDMA",
+		Model:   CDMA,
+		Check:   0x04c34abf,
+		Residue: 0x34efa55a,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
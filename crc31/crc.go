@@ -0,0 +1,34 @@
+package crc31
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/poly31"
+)
+
+var (
+	// CRC-31/PHILIPS
+	Philips = &crc32.Model{
+		Poly:          poly31.New(0x04c11db7),
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+)
+
+// Catalog lists the 31-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc32.CatalogEntry{
+	{
+		Name:    "CRC-31/PHILIPS",
+		Model:   Philips,
+		Check:   0x0ce9e46c,
+		Residue: 0x4eaf26f1,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package crc32
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/poly32"
+)
+
+type Model = crcutil.Model[uint32]
+type Inst = crcutil.Inst[uint32]
+
+type CatalogEntry = crcutil.CatalogEntry[uint32]
+
+var (
+	// CRC-32/AIXM
+	AIXM = &Model{
+		Poly: poly32.New(0x814141ab),
+	}
+
+	// CRC-32/AUTOSAR
+	AUTOSAR = &Model{
+		Poly:          poly32.New(0xf4acfb13),
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+		FinalInvert:   true,
+	}
+
+	// CRC-32/BASE91-D, alias CRC-32D
+	Base91D = &Model{
+		Poly:          poly32.New(0xa833982b),
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+		FinalInvert:   true,
+	}
+
+	// CRC-32/BZIP2, alias CRC-32/AAL5, CRC-32/DECT-B, B-CRC-32
+	BZIP2 = &Model{
+		Poly:          poly32.IEEE,
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+
+	// CRC-32/CD-ROM-EDC
+	CDROMEDC = &Model{
+		Poly:   poly32.New(0x8001801b),
+		RefIn:  true,
+		RefOut: true,
+	}
+
+	// CRC-32/CKSUM, alias CKSUM, CRC-32/POSIX
+	CKSUM = &Model{
+		Poly:        poly32.IEEE,
+		FinalInvert: true,
+	}
+
+	// CRC-32/ISCSI, alias CRC-32/BASE91-C, CRC-32/CASTAGNOLI, CRC-32/INTERLAKEN, CRC-32C, CRC-32/NVME
+	ISCSI = &Model{
+		Poly:          poly32.New(0x1edc6f41),
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+		FinalInvert:   true,
+	}
+
+	// CRC-32/ISO-HDLC, alias CRC-32, CRC-32/ADCCP, CRC-32/V-42, CRC-32/XZ, PKZIP
+	ISOHDLC = &Model{
+		Poly:          poly32.IEEE,
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+		FinalInvert:   true,
+	}
+
+	// CRC-32/JAMCRC, alias JAMCRC
+	JAMCRC = &Model{
+		Poly:          poly32.IEEE,
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+	}
+
+	// CRC-32/MEF
+	MEF = &Model{
+		Poly:          poly32.New(0x741b8cd7),
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+	}
+
+	// CRC-32/MPEG-2
+	MPEG2 = &Model{
+		Poly:          poly32.IEEE,
+		InitialInvert: true,
+	}
+
+	// CRC-32/XFER, alias XFER
+	XFER = &Model{
+		Poly: poly32.New(0x000000af),
+	}
+)
+
+// Catalog lists the 32-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*CatalogEntry{
+	{
+		Name:    "CRC-32/AIXM",
+		Model:   AIXM,
+		Check:   0x3010bf7f,
+		Residue: 0x00000000,
+	},
+	{
+		Name:    "CRC-32/AUTOSAR",
+		Model:   AUTOSAR,
+		Check:   0x1697d06a,
+		Residue: 0x904cddbf,
+	},
+	{
+		Name:    "CRC-32/BASE91-D",
+		Aliases: []string{"CRC-32D"},
+		Model:   Base91D,
+		Check:   0x87315576,
+		Residue: 0x45270551,
+	},
+	{
+		Name:    "CRC-32/BZIP2",
+		Aliases: []string{"CRC-32/AAL5", "CRC-32/DECT-B", "B-CRC-32"},
+		Model:   BZIP2,
+		Check:   0xfc891918,
+		Residue: 0xc704dd7b,
+	},
+	{
+		Name:    "CRC-32/CD-ROM-EDC",
+		Model:   CDROMEDC,
+		Check:   0x6ec2edc4,
+		Residue: 0x00000000,
+	},
+	{
+		Name:    "CRC-32/CKSUM",
+		Aliases: []string{"CKSUM", "CRC-32/POSIX"},
+		Model:   CKSUM,
+		Check:   0x765e7680,
+		Residue: 0xc704dd7b,
+	},
+	{
+		Name:    "CRC-32/ISCSI",
+		Aliases: []string{"CRC-32/BASE91-C", "CRC-32/CASTAGNOLI", "CRC-32/INTERLAKEN", "CRC-32C", "CRC-32/NVME"},
+		Model:   ISCSI,
+		Check:   0xe3069283,
+		Residue: 0xb798b438,
+	},
+	{
+		Name:    "CRC-32/ISO-HDLC",
+		Aliases: []string{"CRC-32", "CRC-32/ADCCP", "CRC-32/V-42", "CRC-32/XZ", "PKZIP"},
+		Model:   ISOHDLC,
+		Check:   0xcbf43926,
+		Residue: 0xdebb20e3,
+	},
+	{
+		Name:    "CRC-32/JAMCRC",
+		Aliases: []string{"JAMCRC"},
+		Model:   JAMCRC,
+		Check:   0x340bc6d9,
+		Residue: 0x00000000,
+	},
+	{
+		Name:    "CRC-32/MEF",
+		Model:   MEF,
+		Check:   0xd2c22f51,
+		Residue: 0x00000000,
+	},
+	{
+		Name:    "CRC-32/MPEG-2",
+		Model:   MPEG2,
+		Check:   0x0376e6e7,
+		Residue: 0x00000000,
+	},
+	{
+		Name:    "CRC-32/XFER",
+		Aliases: []string{"XFER"},
+		Model:   XFER,
+		Check:   0xbd0be338,
+		Residue: 0x00000000,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package crc4
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc8"
+	"github.com/knieriem/crcutil/poly4"
+)
+
+var (
+	// CRC-4/G-704, alias CRC-4/ITU
+	G704 = &crc8.Model{
+		Poly:   poly4.ITU,
+		RefIn:  true,
+		RefOut: true,
+	}
+
+	// CRC-4/INTERLAKEN
+	Interlaken = &crc8.Model{
+		Poly:          poly4.ITU,
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+)
+
+// Catalog lists the 4-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc8.CatalogEntry{
+	{
+		Name:    "CRC-4/G-704",
+		Aliases: []string{"CRC-4/ITU"},
+		Model:   G704,
+		Check:   0x7,
+		Residue: 0x0,
+	},
+	{
+		Name:    "CRC-4/INTERLAKEN",
+		Model:   Interlaken,
+		Check:   0xb,
+		Residue: 0x2,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package crc40
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc64"
+	"github.com/knieriem/crcutil/poly40"
+)
+
+var (
+	// CRC-40/GSM
+	GSM = &crc64.Model{
+		Poly:        poly40.GSM,
+		FinalInvert: true,
+	}
+)
+
+// Catalog lists the 40-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc64.CatalogEntry{
+	{
+		Name:    "CRC-40/GSM",
+		Model:   GSM,
+		Check:   0xd4164fc646,
+		Residue: 0xc4ff8071ff,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
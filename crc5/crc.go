@@ -0,0 +1,63 @@
+package crc5
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc8"
+	"github.com/knieriem/crcutil/poly5"
+)
+
+var (
+	// CRC-5/EPC-C1G2, alias CRC-5/EPC
+	EPCC1G2 = &crc8.Model{
+		Poly:    poly5.New(0x09),
+		Initial: 0x09,
+	}
+
+	// CRC-5/G-704, alias CRC-5/ITU
+	G704 = &crc8.Model{
+		Poly:   poly5.New(0x15),
+		RefIn:  true,
+		RefOut: true,
+	}
+
+	// CRC-5/USB
+	USB = &crc8.Model{
+		Poly:          poly5.New(0x05),
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+		FinalInvert:   true,
+	}
+)
+
+// Catalog lists the 5-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc8.CatalogEntry{
+	{
+		Name:    "CRC-5/EPC-C1G2",
+		Aliases: []string{"CRC-5/EPC"},
+		Model:   EPCC1G2,
+		Check:   0x00,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-5/G-704",
+		Aliases: []string{"CRC-5/ITU"},
+		Model:   G704,
+		Check:   0x07,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-5/USB",
+		Model:   USB,
+		Check:   0x19,
+		Residue: 0x06,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package crc6
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc8"
+	"github.com/knieriem/crcutil/poly6"
+)
+
+var (
+	// CRC-6/CDMA2000-A
+	CDMA2000A = &crc8.Model{
+		Poly:          poly6.New(0x27),
+		InitialInvert: true,
+	}
+
+	// CRC-6/CDMA2000-B
+	CDMA2000B = &crc8.Model{
+		Poly:          poly6.New(0x07),
+		InitialInvert: true,
+	}
+
+	// CRC-6/DARC
+	DARC = &crc8.Model{
+		Poly:   poly6.New(0x19),
+		RefIn:  true,
+		RefOut: true,
+	}
+
+	// CRC-6/G-704, alias CRC-6/ITU
+	G704 = &crc8.Model{
+		Poly:   poly6.New(0x03),
+		RefIn:  true,
+		RefOut: true,
+	}
+
+	// CRC-6/GSM
+	GSM = &crc8.Model{
+		Poly:        poly6.New(0x2f),
+		FinalInvert: true,
+	}
+)
+
+// Catalog lists the 6-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc8.CatalogEntry{
+	{
+		Name:    "CRC-6/CDMA2000-A",
+		Model:   CDMA2000A,
+		Check:   0x0d,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-6/CDMA2000-B",
+		Model:   CDMA2000B,
+		Check:   0x3b,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-6/DARC",
+		Model:   DARC,
+		Check:   0x26,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-6/G-704",
+		Aliases: []string{"CRC-6/ITU"},
+		Model:   G704,
+		Check:   0x06,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-6/GSM",
+		Model:   GSM,
+		Check:   0x13,
+		Residue: 0x3a,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package crc64
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/poly64"
+)
+
+type Model = crcutil.Model[uint64]
+type Inst = crcutil.Inst[uint64]
+
+type CatalogEntry = crcutil.CatalogEntry[uint64]
+
+var (
+	// CRC-64/ECMA-182, alias CRC-64
+	ECMA182 = &Model{
+		Poly: poly64.ECMA,
+	}
+
+	// CRC-64/GO-ISO
+	GoISO = &Model{
+		Poly:          poly64.ISO.ReversedForm(),
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+
+	// CRC-64/MS
+	MS = &Model{
+		Poly:          poly64.New(0x259c84cba6426349),
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+	}
+
+	// CRC-64/NVME
+	NVME = &Model{
+		Poly:          poly64.New(0xad93d23594c93659),
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+		FinalInvert:   true,
+	}
+
+	// CRC-64/REDIS
+	Redis = &Model{
+		Poly:   poly64.New(0xad93d23594c935a9),
+		RefIn:  true,
+		RefOut: true,
+	}
+
+	// CRC-64/WE
+	WE = &Model{
+		Poly:          poly64.ECMA,
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+
+	// CRC-64/XZ, alias CRC-64/GO-ECMA
+	XZ = &Model{
+		Poly:          poly64.ECMA.ReversedForm(),
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+)
+
+// Catalog lists the 64-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*CatalogEntry{
+	{
+		Name:    "CRC-64/ECMA-182",
+		Aliases: []string{"CRC-64"},
+		Model:   ECMA182,
+		Check:   0x6c40df5f0b497347,
+		Residue: 0x0000000000000000,
+	},
+	{
+		Name:    "CRC-64/GO-ISO",
+		Model:   GoISO,
+		Check:   0xb90956c775a41001,
+		Residue: 0x5300000000000000,
+	},
+	{
+		Name:    "CRC-64/MS",
+		Model:   MS,
+		Check:   0x75d4b74f024eceea,
+		Residue: 0x0000000000000000,
+	},
+	{
+		Name:    "CRC-64/NVME",
+		Model:   NVME,
+		Check:   0xae8b14860a799888,
+		Residue: 0xf310303b2b6f6e42,
+	},
+	{
+		Name:    "CRC-64/REDIS",
+		Model:   Redis,
+		Check:   0xe9c6d914c4b8d9ca,
+		Residue: 0x0000000000000000,
+	},
+	{
+		Name:    "CRC-64/WE",
+		Model:   WE,
+		Check:   0x62ec59e3f1a4f00a,
+		Residue: 0xfcacbebd5931a992,
+	},
+	{
+		Name:    "CRC-64/XZ",
+		Aliases: []string{"CRC-64/GO-ECMA"},
+		Model:   XZ,
+		Check:   0x995dc9bbdf1939fa,
+		Residue: 0x49958c9abd7d353f,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
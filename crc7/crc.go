@@ -0,0 +1,58 @@
+package crc7
+
+import (
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc8"
+	"github.com/knieriem/crcutil/poly7"
+)
+
+var (
+	// CRC-7/MMC, alias CRC-7
+	MMC = &crc8.Model{
+		Poly: poly7.New(0x09),
+	}
+
+	// CRC-7/ROHC
+	ROHC = &crc8.Model{
+		Poly:          poly7.New(0x4f),
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+	}
+
+	// CRC-7/UMTS
+	UMTS = &crc8.Model{
+		Poly: poly7.New(0x45),
+	}
+)
+
+// Catalog lists the 7-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*crc8.CatalogEntry{
+	{
+		Name:    "CRC-7/MMC",
+		Aliases: []string{"CRC-7"},
+		Model:   MMC,
+		Check:   0x75,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-7/ROHC",
+		Model:   ROHC,
+		Check:   0x53,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-7/UMTS",
+		Model:   UMTS,
+		Check:   0x61,
+		Residue: 0x00,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -8,14 +8,265 @@ import (
 type Model = crcutil.Model[uint8]
 type Inst = crcutil.Inst[uint8]
 
+type CatalogEntry = crcutil.CatalogEntry[uint8]
+
 var (
+	// CRC-8/AUTOSAR
+	AUTOSAR = &Model{
+		Poly:          poly8.New(0x2f),
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+
+	// CRC-8/BLUETOOTH
+	Bluetooth = &Model{
+		Poly:   poly8.New(0xa7),
+		RefIn:  true,
+		RefOut: true,
+	}
+
+	// CRC-8/CDMA2000
+	CDMA2000 = &Model{
+		Poly:          poly8.New(0x9b),
+		InitialInvert: true,
+	}
+
+	// CRC-8/DARC
+	DARC = &Model{
+		Poly:   poly8.New(0x39),
+		RefIn:  true,
+		RefOut: true,
+	}
+
+	// CRC-8/DVB-S2
+	DVBS2 = &Model{
+		Poly: poly8.New(0xd5),
+	}
+
+	// CRC-8/GSM-A
+	GSMA = &Model{
+		Poly: poly8.SAEJ1850,
+	}
+
+	// CRC-8/GSM-B
+	GSMB = &Model{
+		Poly:        poly8.New(0x49),
+		FinalInvert: true,
+	}
+
+	// CRC-8/HITAG
+	Hitag = &Model{
+		Poly:          poly8.SAEJ1850,
+		InitialInvert: true,
+	}
+
+	// CRC-8/I-432-1, alias CRC-8/ITU
+	I4321 = &Model{
+		Poly:     poly8.New(0x07),
+		FinalXOR: 0x55,
+	}
+
+	// CRC-8/I-CODE
+	ICode = &Model{
+		Poly:    poly8.SAEJ1850,
+		Initial: 0xfd,
+	}
+
+	// CRC-8/LTE
+	LTE = &Model{
+		Poly: poly8.New(0x9b),
+	}
+
+	// CRC-8/MAXIM-DOW, alias CRC-8/MAXIM, DOW-CRC
 	DOW = &Model{
 		Poly: poly8.DOW.ReversedForm(),
 	}
 
+	// CRC-8/MIFARE-MAD
+	MifareMAD = &Model{
+		Poly:    poly8.SAEJ1850,
+		Initial: 0xc7,
+	}
+
+	// CRC-8/NRSC-5
+	NRSC5 = &Model{
+		Poly:          poly8.DOW,
+		InitialInvert: true,
+	}
+
+	// CRC-8/OPENSAFETY
+	OpenSafety = &Model{
+		Poly: poly8.New(0x2f),
+	}
+
+	// CRC-8/ROHC
+	ROHC = &Model{
+		Poly:          poly8.New(0x07),
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+	}
+
+	// CRC-8/SAE-J1850
 	SAEJ1850 = &Model{
 		Poly:          poly8.SAEJ1850,
 		InitialInvert: true,
 		FinalInvert:   true,
 	}
+
+	// CRC-8/SMBUS, alias CRC-8
+	SMBus = &Model{
+		Poly: poly8.New(0x07),
+	}
+
+	// CRC-8/TECH-3250, alias CRC-8/AES, CRC-8/EBU
+	Tech3250 = &Model{
+		Poly:          poly8.SAEJ1850,
+		InitialInvert: true,
+		RefIn:         true,
+		RefOut:        true,
+	}
+
+	// CRC-8/WCDMA
+	WCDMA = &Model{
+		Poly:   poly8.New(0x9b),
+		RefIn:  true,
+		RefOut: true,
+	}
 )
+
+// Catalog lists the 8-bit algorithms of the CRC RevEng catalogue.
+var Catalog = []*CatalogEntry{
+	{
+		Name:    "CRC-8/AUTOSAR",
+		Model:   AUTOSAR,
+		Check:   0xdf,
+		Residue: 0x42,
+	},
+	{
+		Name:    "CRC-8/BLUETOOTH",
+		Model:   Bluetooth,
+		Check:   0x26,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/CDMA2000",
+		Model:   CDMA2000,
+		Check:   0xda,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/DARC",
+		Model:   DARC,
+		Check:   0x15,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/DVB-S2",
+		Model:   DVBS2,
+		Check:   0xbc,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/GSM-A",
+		Model:   GSMA,
+		Check:   0x37,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/GSM-B",
+		Model:   GSMB,
+		Check:   0x94,
+		Residue: 0x53,
+	},
+	{
+		Name:    "CRC-8/HITAG",
+		Model:   Hitag,
+		Check:   0xb4,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/I-432-1",
+		Aliases: []string{"CRC-8/ITU"},
+		Model:   I4321,
+		Check:   0xa1,
+		Residue: 0xac,
+	},
+	{
+		Name:    "CRC-8/I-CODE",
+		Model:   ICode,
+		Check:   0x7e,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/LTE",
+		Model:   LTE,
+		Check:   0xea,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/MAXIM-DOW",
+		Aliases: []string{"CRC-8/MAXIM", "DOW-CRC"},
+		Model:   DOW,
+		Check:   0xa1,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/MIFARE-MAD",
+		Model:   MifareMAD,
+		Check:   0x99,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/NRSC-5",
+		Model:   NRSC5,
+		Check:   0xf7,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/OPENSAFETY",
+		Model:   OpenSafety,
+		Check:   0x3e,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/ROHC",
+		Model:   ROHC,
+		Check:   0xd0,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/SAE-J1850",
+		Model:   SAEJ1850,
+		Check:   0x4b,
+		Residue: 0xc4,
+	},
+	{
+		Name:    "CRC-8/SMBUS",
+		Aliases: []string{"CRC-8"},
+		Model:   SMBus,
+		Check:   0xf4,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/TECH-3250",
+		Aliases: []string{"CRC-8/AES", "CRC-8/EBU"},
+		Model:   Tech3250,
+		Check:   0x97,
+		Residue: 0x00,
+	},
+	{
+		Name:    "CRC-8/WCDMA",
+		Model:   WCDMA,
+		Check:   0x25,
+		Residue: 0x00,
+	},
+}
+
+func init() {
+	for _, e := range Catalog {
+		if err := crcutil.Register(e); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package crcutil
+
+import "github.com/knieriem/crcutil/internal/impl"
+
+// Hooks for tests in package crcutil_test.
+var (
+	HasCLMUL     = impl.HasCLMUL
+	WithoutCLMUL = withoutCLMUL
+)
+
+// UsesStdlib reports whether inst delegates
+// processing of bytes to the standard library.
+func UsesStdlib[T Word](inst *Inst[T]) bool {
+	switch any(inst.impl).(type) {
+	case impl.Std32, impl.Std64:
+		return true
+	}
+	return false
+}
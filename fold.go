@@ -0,0 +1,75 @@
+package crcutil
+
+import (
+	"math/bits"
+	"unsafe"
+
+	"github.com/knieriem/crcutil/internal/impl"
+)
+
+// WithFolding enables or disables a folding implementation that,
+// using carry-less multiplication, processes 16 bytes per step;
+// bytes remaining are processed using the table-driven implementation.
+// On default, folding is used for 32- and 64-bit word types, if the CPU
+// supports carry-less multiplication, which currently is detected
+// on amd64 only, and neither slicing-by-n is used, nor a custom Table
+// is specified.
+// If folding is enabled explicitly on a CPU without that support,
+// a slow pure Go implementation is used, which is mainly useful for testing.
+// The option is ignored, if the Model specifies a custom Table
+// or a polynomial in reciprocal form, or WithSwappedInputNibbles is used.
+func WithFolding(enable bool) InstOption {
+	return func(c *instConf) {
+		if enable {
+			c.folding = foldingOn
+		} else {
+			c.folding = foldingOff
+		}
+	}
+}
+
+const (
+	foldingAuto = iota
+	foldingOn
+	foldingOff
+)
+
+// useFolding reports whether instances of m created
+// with configuration c shall use a folding implementation.
+func useFolding[T Word](m *Model[T], c *instConf) bool {
+	if m.Table != nil || m.Poly.Reciprocal || c.compSwapInputNibbles {
+		return false
+	}
+	switch c.folding {
+	case foldingOn:
+		return true
+	case foldingOff:
+		return false
+	}
+	var x T
+	return impl.HasCLMUL() && !c.noCLMUL && c.slices == 0 && unsafe.Sizeof(x) >= 4
+}
+
+// withoutCLMUL ensures that a folding implementation performs
+// carry-less multiplication in software, even if the CPU supports it.
+// It is used by tests to verify the pure Go implementation.
+func withoutCLMUL() InstOption {
+	return func(c *instConf) {
+		c.noCLMUL = true
+	}
+}
+
+// foldingImpl returns an implementation that folds blocks of 16 bytes
+// using carry-less multiplication, and processes the result,
+// and remaining bytes, using base. The folding constants are derived from p.
+// If clmul is false, multiplication is performed in software.
+func (p *Poly[T]) foldingImpl(base Impl[T], clmul bool) Impl[T] {
+	g := newGF2(p)
+	f := impl.Fold[T]{Base: base, Width: p.Width, LSBitFirst: p.LSBitFirst(), CLMUL: clmul}
+	if f.LSBitFirst {
+		f.K = [2]uint64{bits.Reverse64(g.xPow(191, 1)), bits.Reverse64(g.xPow(127, 1))}
+	} else {
+		f.K = [2]uint64{g.xPow(128, 1), g.xPow(192, 1)}
+	}
+	return f
+}
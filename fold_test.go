@@ -0,0 +1,103 @@
+package crcutil_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/poly32"
+)
+
+// TestFolding verifies folding implementations, using
+// carry-less multiplication instructions, if available,
+// and the pure Go variant, against bitwise calculation
+// for random polynomials in normal and reversed form.
+func TestFolding(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 1000)
+	rnd.Read(data)
+
+	for _, clmul := range []bool{true, false} {
+		if clmul && !crcutil.HasCLMUL() {
+			t.Log("carry-less multiplication not supported")
+			continue
+		}
+		opts := []crcutil.InstOption{crcutil.WithFolding(true)}
+		if !clmul {
+			opts = append(opts, crcutil.WithoutCLMUL())
+		}
+		for i := 0; i < 50; i++ {
+			checkFolding[uint32](t, rnd, 8+rnd.Intn(25), data, opts)
+			checkFolding[uint64](t, rnd, 8+rnd.Intn(57), data, opts)
+		}
+	}
+}
+
+func checkFolding[T crcutil.Word](t *testing.T, rnd *rand.Rand, width int, data []byte, opts []crcutil.InstOption) {
+	mask := ^uint64(0) >> (64 - width)
+	p := &crcutil.Poly[T]{Word: T(rnd.Uint64()&mask | 1), Width: width}
+	if rnd.Intn(2) == 1 {
+		p = p.ReversedForm()
+	}
+	m := &crcutil.Model[T]{Poly: p, Initial: T(rnd.Uint64() & mask)}
+
+	for _, l := range []int{0, 63, 64, 65, 100, 128, len(data)} {
+		want := m.Initial
+		for _, b := range data[:l] {
+			want = crcutil.UpdateBitwise(p, want, T(b), 8)
+		}
+		inst := m.New(opts...)
+		inst.Update(data[:l])
+		if got := inst.Sum(); got != want {
+			t.Errorf("%#v, %d bytes: want %#x, got %#x", p, l, want, got)
+		}
+	}
+}
+
+// TestFoldingReciprocal verifies that models using a polynomial
+// in reciprocal form, which are not supported by folding,
+// calculate the same checksum as bitwise calculation.
+func TestFoldingReciprocal(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 200)
+	rnd.Read(data)
+
+	for _, tc := range []struct {
+		name string
+		poly *crcutil.Poly[uint32]
+		opts []crcutil.InstOption
+	}{
+		{"reciprocal", poly32.IEEE.ReciprocalForm(), nil},
+		{"reciprocal, folding", poly32.IEEE.ReciprocalForm(), []crcutil.InstOption{crcutil.WithFolding(true)}},
+		{"reversed reciprocal", poly32.IEEE.ReversedForm().ReciprocalForm(), nil},
+		{"reversed reciprocal, folding", poly32.IEEE.ReversedForm().ReciprocalForm(), []crcutil.InstOption{crcutil.WithFolding(true)}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &crcutil.Model[uint32]{Poly: tc.poly}
+			var want uint32
+			for _, b := range data {
+				want = crcutil.UpdateBitwise(tc.poly, want, uint32(b), 8)
+			}
+			inst := m.New(tc.opts...)
+			inst.Update(data)
+			if got := inst.Sum(); got != want {
+				t.Errorf("want %#x, got %#x", want, got)
+			}
+		})
+	}
+}
+
+func BenchmarkFolding(b *testing.B) {
+	data := make([]byte, 4096)
+	for _, folding := range []bool{false, true} {
+		b.Run(fmt.Sprintf("folding=%t", folding), func(b *testing.B) {
+			inst := crc32.MPEG2.New(crcutil.WithFolding(folding))
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				inst.Update(data)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package crcutil
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// Forge returns the bytes that, stored into data at position pos,
+// replacing the bytes there, result in a checksum of the whole data
+// equal to target. The number of bytes returned is the
+// minimum number of bytes required to hold the checksum, (Width+7)/8.
+// The contents of the bytes at pos within data are ignored;
+// data itself is not modified.
+//
+// Since the crc calculation is linear, each bit of the patch field contributes
+// a fixed value to the checksum, depending on the number of bits
+// following it; the patch is determined by solving
+// the resulting system of linear equations over GF(2).
+func (m *Model[T]) Forge(data []byte, pos int, target T) ([]byte, error) {
+	p := m.Poly
+	n := (p.Width + 7) / 8
+	if pos < 0 || pos+n > len(data) {
+		return nil, errors.New("crcutil: forge position out of range")
+	}
+	if p.NormalForm().Word&1 == 0 {
+		return nil, errors.New("crcutil: polynomial lacks the +1 term")
+	}
+
+	// checksum with the patch field set to zero
+	inst := m.New()
+	inst.Update(data[:pos])
+	inst.UpdateZeros(int64(n))
+	inst.Update(data[pos+n:])
+	need := uint64(target ^ inst.Sum())
+
+	// contributions of the single bits of the patch field
+	g := newGF2(p)
+	tail := uint64(len(data)-pos-n) * 8
+	var cols [64]uint64
+	for j := 0; j < 8*n; j++ {
+		k, i := j/8, j%8
+		if m.reflectsIn() {
+			i = 7 - i
+		}
+		after := tail + uint64(8*(n-1-k)+i)
+		r := g.xPow(1, after+uint64(p.Width))
+		cols[j] = uint64(m.reflectOut(m.procReg(r)))
+	}
+
+	v, ok := solveGF2(cols[:8*n], need)
+	if !ok {
+		return nil, errors.New("crcutil: no solution found")
+	}
+	patch := make([]byte, n)
+	for k := range patch {
+		patch[k] = byte(v >> (8 * k))
+	}
+	return patch, nil
+}
+
+// solveGF2 returns a combination of columns, as a bit mask,
+// that XOR-ed result in the value y.
+func solveGF2(cols []uint64, y uint64) (uint64, bool) {
+	type basisVec struct {
+		v, comb uint64
+	}
+	// each vector's pivot is its highest bit, which
+	// is not set in any vector added later
+	var basis []basisVec
+	pivot := func(v uint64) uint64 {
+		return 1 << (63 - bits.LeadingZeros64(v))
+	}
+	reduce := func(b basisVec) basisVec {
+		for _, e := range basis {
+			if b.v&pivot(e.v) != 0 {
+				b.v ^= e.v
+				b.comb ^= e.comb
+			}
+		}
+		return b
+	}
+	for j, c := range cols {
+		if b := reduce(basisVec{c, 1 << j}); b.v != 0 {
+			basis = append(basis, b)
+		}
+	}
+	b := reduce(basisVec{y, 0})
+	return b.comb, b.v == 0
+}
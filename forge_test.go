@@ -0,0 +1,95 @@
+package crcutil_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc12"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/crc24"
+	crc3cat "github.com/knieriem/crcutil/crc3"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/crc5"
+	"github.com/knieriem/crcutil/crc64"
+	"github.com/knieriem/crcutil/crc8"
+)
+
+func ExampleModel_Forge() {
+	image := []byte("firmware image ....")
+	m := crc32.ISOHDLC
+	patch, err := m.Forge(image, len(image)-4, 0xdeadbeef)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	copy(image[len(image)-4:], patch)
+	fmt.Printf("%#08x\n", m.Checksum(image))
+	// Output: 0xdeadbeef
+}
+
+// TestForge verifies that patches computed by Forge result in the
+// target checksum, including widths that are not multiples of eight,
+// and that, for widths that are, the original bytes of the check input
+// are recovered as the unique patch resulting in the check value.
+func TestForge(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	testForge(t, crc3cat.Catalog, rnd)
+	testForge(t, crc5.Catalog, rnd)
+	testForge(t, crc8.Catalog, rnd)
+	testForge(t, crc12.Catalog, rnd)
+	testForge(t, crc16.Catalog, rnd)
+	testForge(t, crc24.Catalog, rnd)
+	testForge(t, crc32.Catalog, rnd)
+	testForge(t, crc64.Catalog, rnd)
+}
+
+func testForge[T crcutil.Word](t *testing.T, cat []*crcutil.CatalogEntry[T], rnd *rand.Rand) {
+	data := make([]byte, 50)
+	for _, e := range cat {
+		t.Run(e.Name, func(t *testing.T) {
+			m := e.Model
+			w := m.Poly.Width
+			n := (w + 7) / 8
+			mask := ^uint64(0) >> (64 - w)
+			for _, pos := range []int{0, 1, 20, len(data) - n} {
+				rnd.Read(data)
+				orig := append([]byte(nil), data...)
+				target := T(rnd.Uint64() & mask)
+				patch, err := m.Forge(data, pos, target)
+				if err != nil {
+					t.Fatalf("pos %d: %v", pos, err)
+				}
+				if len(patch) != n {
+					t.Fatalf("pos %d: patch size %d", pos, len(patch))
+				}
+				if !bytes.Equal(data, orig) {
+					t.Fatalf("pos %d: data modified", pos)
+				}
+				copy(data[pos:], patch)
+				if sum := m.Checksum(data); sum != target {
+					t.Errorf("pos %d: want %#x, got %#x", pos, target, sum)
+				}
+			}
+
+			if w%8 == 0 {
+				msg := []byte(crcutil.CheckInput)
+				copy(msg[1:], make([]byte, n))
+				patch, err := m.Forge(msg, 1, e.Check)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := checkData[1 : 1+n]; !bytes.Equal(patch, want) {
+					t.Errorf("check input: want patch % x, got % x", want, patch)
+				}
+			}
+
+			if _, err := m.Forge(data, len(data)-n+1, 0); err == nil {
+				t.Error("missing error for position out of range")
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package crcutil
+
+// gf2 provides arithmetic on polynomials over GF(2) modulo
+// a polynomial of up to 64 bits, given in msbit-first form.
+// Values are polynomials of degree less than width, represented
+// as words with the coefficient of x^0 in the least significant bit.
+type gf2 struct {
+	poly  uint64
+	width int
+	mask  uint64
+}
+
+// newGF2 returns the arithmetic modulo the polynomial that is used
+// for processing input; a reciprocal form is not converted.
+func newGF2[T Word](p *Poly[T]) *gf2 {
+	n := p.msbitFirst()
+	return &gf2{
+		poly:  uint64(n.Word),
+		width: n.Width,
+		mask:  ^uint64(0) >> (64 - n.Width),
+	}
+}
+
+// mulX returns a·x mod P.
+func (g *gf2) mulX(a uint64) uint64 {
+	top := a >> (g.width - 1) & 1
+	a = a << 1 & g.mask
+	if top != 0 {
+		a ^= g.poly
+	}
+	return a
+}
+
+// divX returns a·x⁻¹ mod P; P must have the +1 term.
+func (g *gf2) divX(a uint64) uint64 {
+	if a&1 != 0 {
+		return (a^g.poly)>>1 | 1<<(g.width-1)
+	}
+	return a >> 1
+}
+
+// mul returns a·b mod P.
+func (g *gf2) mul(a, b uint64) uint64 {
+	var r uint64
+	for i := g.width - 1; i >= 0; i-- {
+		r = g.mulX(r)
+		if b>>i&1 != 0 {
+			r ^= a
+		}
+	}
+	return r
+}
+
+// xPow returns x^n mod P, for n = base·k, where base is
+// a number of bits, like 8 in case of bytes.
+func (g *gf2) xPow(base int, k uint64) uint64 {
+	b := uint64(1)
+	for i := 0; i < base; i++ {
+		b = g.mulX(b)
+	}
+	r := uint64(1)
+	for ; k != 0; k >>= 1 {
+		if k&1 != 0 {
+			r = g.mul(r, b)
+		}
+		b = g.mul(b, b)
+	}
+	return r
+}
@@ -47,3 +47,33 @@ func FromImplicit1NotationReciprocal[T Word](k T) *Poly[T] {
 	p.Reciprocal = true
 	return p
 }
+
+// Koopman returns the word of the polynomial in Koopman's implicit +1 notation.
+// It is the inverse of [FromImplicit1Notation], and may be called on a Poly
+// in any representation.
+func (p *Poly[T]) Koopman() T {
+	return p.NormalForm().implicit1()
+}
+
+// KoopmanReciprocal is the inverse of [FromImplicit1NotationReciprocal]:
+// it returns the word in implicit +1 notation of the reciprocal
+// of the polynomial.
+func (p *Poly[T]) KoopmanReciprocal() T {
+	return p.NormalForm().makeReciprocal().implicit1()
+}
+
+// implicit1 converts the word of a polynomial in normal form
+// into implicit +1 notation.
+func (p *Poly[T]) implicit1() T {
+	// Drop the explicit +1 bit, and add the topmost bit.
+	return p.Word>>1 | T(1)<<(p.Width-1)
+}
+
+// Equal reports whether p and q describe the same polynomial,
+// regardless of their representations.
+func (p *Poly[T]) Equal(q *Poly[T]) bool {
+	if p.Width != q.Width {
+		return false
+	}
+	return p.NormalForm().Word == q.NormalForm().Word
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/poly16"
 )
 
 func ExampleFromImplicit1Notation() {
@@ -52,3 +53,48 @@ func Test_Implicit1NotationVsAppearance(t *testing.T) {
 	pa.Reciprocal = false
 	runTest(0x0811)
 }
+
+func ExamplePoly_Koopman() {
+	p := poly16.CCITT.ReversedForm()
+	fmt.Printf("%#04x %#04x", p.Koopman(), p.KoopmanReciprocal())
+	// Output: 0x8810 0x8408
+}
+
+// This test verifies that converting a Poly in any representation
+// to implicit +1 notation and back results in an equal Poly.
+func TestKoopmanRoundTrip(t *testing.T) {
+	p := crcutil.FromImplicit1Notation(uint32(0x19d17))
+	forms := []*crcutil.Poly[uint32]{
+		p,
+		p.ReversedForm(),
+		p.ReciprocalForm(),
+		p.ReversedForm().ReciprocalForm(),
+	}
+	for _, f := range forms {
+		if k := f.Koopman(); k != 0x19d17 {
+			t.Errorf("%#v: unexpected word: %#05x", f, k)
+		}
+		if q := crcutil.FromImplicit1Notation(f.Koopman()); !q.Equal(f) {
+			t.Errorf("%#v: not equal to %#v", f, q)
+		}
+		k := f.KoopmanReciprocal()
+		if q := crcutil.FromImplicit1NotationReciprocal(k); !q.Equal(f) {
+			t.Errorf("%#v: reciprocal: not equal to %#v", f, q)
+		}
+	}
+	if k := p.KoopmanReciprocal(); k != 0x1e8b9 {
+		t.Errorf("unexpected reciprocal word: %#05x", k)
+	}
+}
+
+func TestPolyEqual(t *testing.T) {
+	if !poly16.CCITT.Equal(poly16.CCITT.ReversedForm()) {
+		t.Error("normal and reversed form not equal")
+	}
+	if poly16.CCITT.Equal(poly16.IBM) {
+		t.Error("different polynomials equal")
+	}
+	if poly16.CCITT.Equal(&poly16.Poly{Word: 0x1021, Width: 15}) {
+		t.Error("polynomials of different widths equal")
+	}
+}
@@ -0,0 +1,99 @@
+package impl
+
+import (
+	"encoding/binary"
+)
+
+// Updater is the interface implemented by table based implementations,
+// as used by Fold to process the bytes that remain after folding.
+type Updater[T Word] interface {
+	Update(crc T, tab []T, p []byte) T
+	Append(in []byte, crc T) []byte
+}
+
+// Fold processes data by folding blocks of 16 bytes into a 128-bit
+// accumulator using carry-less multiplication; the accumulator,
+// which is congruent to the data modulo the polynomial,
+// and remaining bytes are then processed using Base.
+// If CLMUL is set, and the CPU supports it, PCLMULQDQ instructions
+// are used, otherwise multiplication is performed in software.
+//
+// The accumulator is held in two 64-bit words, like in an XMM register.
+// For polynomials in normal form, a block is loaded big-endian,
+// for lsbit-first processing little-endian.
+// K contains the folding constants to be multiplied with the
+// lower and upper word of the accumulator: x^128 mod P and x^192 mod P
+// in normal form, or, in case of lsbit-first processing,
+// the bit-reversed values of x^191 mod P and x^127 mod P.
+type Fold[T Word] struct {
+	Base       Updater[T]
+	Width      int
+	LSBitFirst bool
+	K          [2]uint64
+	CLMUL      bool
+}
+
+// minFoldLen is the minimum number of bytes processed by folding;
+// shorter slices are processed by the Base implementation.
+const minFoldLen = 64
+
+func (impl Fold[T]) Update(crc T, tab []T, p []byte) T {
+	if len(p) < minFoldLen {
+		return impl.Base.Update(crc, tab, p)
+	}
+	n := len(p) &^ 15
+
+	var acc [2]uint64
+	var buf [16]byte
+	if impl.LSBitFirst {
+		acc[0] = binary.LittleEndian.Uint64(p) ^ uint64(crc)
+		acc[1] = binary.LittleEndian.Uint64(p[8:])
+	} else {
+		acc[0] = binary.BigEndian.Uint64(p[8:])
+		acc[1] = binary.BigEndian.Uint64(p) ^ uint64(crc)<<(64-impl.Width)
+	}
+	impl.fold(&acc, p[16:n])
+	if impl.LSBitFirst {
+		binary.LittleEndian.PutUint64(buf[:], acc[0])
+		binary.LittleEndian.PutUint64(buf[8:], acc[1])
+	} else {
+		binary.BigEndian.PutUint64(buf[:], acc[1])
+		binary.BigEndian.PutUint64(buf[8:], acc[0])
+	}
+	crc = impl.Base.Update(0, tab, buf[:])
+	return impl.Base.Update(crc, tab, p[n:])
+}
+
+func (impl Fold[T]) Append(in []byte, crc T) []byte {
+	return impl.Base.Append(in, crc)
+}
+
+// foldGeneric folds the 16-byte blocks of p into acc,
+// using the constants in k, performing multiplication in software.
+func foldGeneric(acc *[2]uint64, p []byte, k *[2]uint64, lsbitFirst bool) {
+	for ; len(p) >= 16; p = p[16:] {
+		var lo, hi uint64
+		if lsbitFirst {
+			lo = binary.LittleEndian.Uint64(p)
+			hi = binary.LittleEndian.Uint64(p[8:])
+		} else {
+			lo = binary.BigEndian.Uint64(p[8:])
+			hi = binary.BigEndian.Uint64(p)
+		}
+		h0, l0 := clmul(acc[0], k[0])
+		h1, l1 := clmul(acc[1], k[1])
+		acc[0] = l0 ^ l1 ^ lo
+		acc[1] = h0 ^ h1 ^ hi
+	}
+}
+
+// clmul returns the carry-less product of a and b.
+func clmul(a, b uint64) (hi, lo uint64) {
+	for i := 0; i < 64; i++ {
+		if b>>i&1 != 0 {
+			lo ^= a << i
+			hi ^= a >> (64 - i)
+		}
+	}
+	return hi, lo
+}
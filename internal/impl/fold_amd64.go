@@ -0,0 +1,48 @@
+package impl
+
+var hasCLMUL = detectCLMUL()
+
+// HasCLMUL reports whether the CPU supports the PCLMULQDQ
+// and PSHUFB instructions used by Fold.
+func HasCLMUL() bool {
+	return hasCLMUL
+}
+
+func detectCLMUL() bool {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 1 {
+		return false
+	}
+	_, _, ecx, _ := cpuid(1, 0)
+	const (
+		pclmulqdq = 1 << 1
+		ssse3     = 1 << 9
+	)
+	return ecx&pclmulqdq != 0 && ecx&ssse3 != 0
+}
+
+var (
+	shufReverse = [16]byte{15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0}
+	shufNone    = [16]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+)
+
+// fold folds the 16-byte blocks of p into acc.
+func (impl Fold[T]) fold(acc *[2]uint64, p []byte) {
+	if !impl.CLMUL || !hasCLMUL {
+		foldGeneric(acc, p, &impl.K, impl.LSBitFirst)
+		return
+	}
+	shuf := &shufReverse
+	if impl.LSBitFirst {
+		shuf = &shufNone
+	}
+	foldCLMUL(acc, p, &impl.K, shuf)
+}
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+// foldCLMUL folds the 16-byte blocks of p into acc, using the constants in k.
+// Blocks are loaded after shuffling their bytes according to shuf.
+//
+//go:noescape
+func foldCLMUL(acc *[2]uint64, p []byte, k *[2]uint64, shuf *[16]byte)
@@ -0,0 +1,41 @@
+#include "textflag.h"
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func foldCLMUL(acc *[2]uint64, p []byte, k *[2]uint64, shuf *[16]byte)
+TEXT ·foldCLMUL(SB), NOSPLIT, $0-48
+	MOVQ acc+0(FP), AX
+	MOVQ p_base+8(FP), SI
+	MOVQ p_len+16(FP), CX
+	MOVQ k+32(FP), BX
+	MOVQ shuf+40(FP), DX
+	MOVOU (AX), X0
+	MOVOU (BX), X1
+	MOVOU (DX), X2
+
+loop:
+	CMPQ CX, $16
+	JB   done
+	MOVOU (SI), X3
+	PSHUFB X2, X3
+	MOVOU X0, X4
+	PCLMULQDQ $0x00, X1, X0
+	PCLMULQDQ $0x11, X1, X4
+	PXOR X4, X0
+	PXOR X3, X0
+	ADDQ $16, SI
+	SUBQ $16, CX
+	JMP  loop
+
+done:
+	MOVOU X0, (AX)
+	RET
@@ -0,0 +1,15 @@
+//go:build !amd64
+
+package impl
+
+// HasCLMUL reports whether the CPU supports the instructions used
+// by an assembly implementation of Fold; on this architecture,
+// there is none.
+func HasCLMUL() bool {
+	return false
+}
+
+// fold folds the 16-byte blocks of p into acc.
+func (impl Fold[T]) fold(acc *[2]uint64, p []byte) {
+	foldGeneric(acc, p, &impl.K, impl.LSBitFirst)
+}
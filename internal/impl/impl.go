@@ -2,9 +2,17 @@
 package impl
 
 type Word interface {
-	uint8 | uint16 | uint32
+	uint8 | uint16 | uint32 | uint64
 }
 
+// Word16, Word32, and Word64 restrict the word types of implementations
+// that shift the crc register by 8 bits or more to types wide enough.
+type (
+	Word16 interface{ uint16 | uint32 | uint64 }
+	Word32 interface{ uint32 | uint64 }
+	Word64 interface{ uint64 }
+)
+
 type Impl8[T Word] struct{}
 
 func (impl Impl8[T]) Update(crc T, tab []T, p []byte) T {
@@ -18,7 +26,7 @@ func (impl Impl8[T]) Append(in []byte, crc T) []byte {
 	return append(in, byte(crc))
 }
 
-type Impl16[T Word] struct{}
+type Impl16[T Word16] struct{}
 
 func (impl Impl16[T]) Update(crc T, tab []T, p []byte) T {
 	for _, v := range p {
@@ -31,7 +39,7 @@ func (impl Impl16[T]) Append(in []byte, crc T) []byte {
 	return append(in, byte(crc>>8), byte(crc))
 }
 
-type Impl32[T Word] struct{}
+type Impl32[T Word32] struct{}
 
 func (impl Impl32[T]) Update(crc T, tab []T, p []byte) T {
 	for _, v := range p {
@@ -44,7 +52,68 @@ func (impl Impl32[T]) Append(in []byte, crc T) []byte {
 	return append(in, byte(crc>>24), byte(crc>>16), byte(crc>>8), byte(crc))
 }
 
-type Impl16LSBitFirst[T Word] struct{}
+type Impl64[T Word64] struct{}
+
+func (impl Impl64[T]) Update(crc T, tab []T, p []byte) T {
+	for _, v := range p {
+		crc = tab[byte(crc>>56)^v] ^ (crc << 8)
+	}
+	return crc
+}
+
+func (impl Impl64[T]) Append(in []byte, crc T) []byte {
+	return append(in,
+		byte(crc>>56), byte(crc>>48), byte(crc>>40), byte(crc>>32),
+		byte(crc>>24), byte(crc>>16), byte(crc>>8), byte(crc))
+}
+
+// ImplNarrow8 processes bytes using a polynomial in normal form
+// that is narrower than eight bits. Table entries are expected
+// to be right-aligned, as created by crcutil.Poly.MakeTable.
+// Append stores the crc in the most significant bits of a byte.
+type ImplNarrow8[T Word] struct {
+	Width int
+}
+
+func (impl ImplNarrow8[T]) Update(crc T, tab []T, p []byte) T {
+	shift := 8 - impl.Width
+	for _, v := range p {
+		crc = tab[byte(crc<<shift)^v]
+	}
+	return crc
+}
+
+func (impl ImplNarrow8[T]) Append(in []byte, crc T) []byte {
+	return append(in, byte(crc<<(8-impl.Width)))
+}
+
+// ImplNarrow processes bytes using a polynomial in normal form
+// having a width of at least eight bits, but narrower than T.
+// Table entries are expected to be right-aligned.
+// Append stores the crc big-endian into the minimum number of bytes
+// required, aligned to the most significant bit of the first byte.
+type ImplNarrow[T Word16] struct {
+	Width int
+}
+
+func (impl ImplNarrow[T]) Update(crc T, tab []T, p []byte) T {
+	shift := impl.Width - 8
+	for _, v := range p {
+		crc = tab[byte(crc>>shift)^v] ^ (crc << 8)
+	}
+	return crc & (T(1)<<impl.Width - 1)
+}
+
+func (impl ImplNarrow[T]) Append(in []byte, crc T) []byte {
+	n := (impl.Width + 7) / 8
+	crc <<= n*8 - impl.Width
+	for i := n - 1; i >= 0; i-- {
+		in = append(in, byte(crc>>(i*8)))
+	}
+	return in
+}
+
+type Impl16LSBitFirst[T Word16] struct{}
 
 func (impl Impl16LSBitFirst[T]) Update(crc T, tab []T, p []byte) T {
 	for _, v := range p {
@@ -57,8 +126,16 @@ func (impl Impl16LSBitFirst[T]) Append(in []byte, crc T) []byte {
 	return append(in, byte(crc), byte(crc>>8))
 }
 
-type Impl32LSBitFirst[T Word] struct{ Impl16LSBitFirst[T] }
+type Impl32LSBitFirst[T Word32] struct{ Impl16LSBitFirst[T] }
 
 func (impl Impl32LSBitFirst[T]) Append(in []byte, crc T) []byte {
 	return append(in, byte(crc), byte(crc>>8), byte(crc>>16), byte(crc>>24))
 }
+
+type Impl64LSBitFirst[T Word64] struct{ Impl16LSBitFirst[T] }
+
+func (impl Impl64LSBitFirst[T]) Append(in []byte, crc T) []byte {
+	return append(in,
+		byte(crc), byte(crc>>8), byte(crc>>16), byte(crc>>24),
+		byte(crc>>32), byte(crc>>40), byte(crc>>48), byte(crc>>56))
+}
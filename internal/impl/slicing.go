@@ -0,0 +1,159 @@
+package impl
+
+// Slicing implementations process N bytes at once, using N tables
+// of 256 entries each, stored consecutively in the table passed to Update.
+// The k-th table contains the crc values of each byte value
+// followed by k zero bytes. Remaining bytes that do not fill
+// a block of N bytes are processed using the first table only.
+// N must be one of 4, 8, or 16, and not less than the size of T in bytes.
+
+type Slicing16[T Word16] struct {
+	Impl16[T]
+	N int
+}
+
+func (impl Slicing16[T]) Update(crc T, tab []T, p []byte) T {
+	n := impl.N
+	if len(p) >= n {
+		t := sliceTables(tab, n)
+		for len(p) >= n {
+			crc ^= T(p[0])<<8 | T(p[1])
+			c := t[n-1][byte(crc>>8)] ^ t[n-2][byte(crc)]
+			for i := 2; i < n; i++ {
+				c ^= t[n-1-i][p[i]]
+			}
+			crc = c
+			p = p[n:]
+		}
+	}
+	return impl.Impl16.Update(crc, tab, p)
+}
+
+type Slicing16LSBitFirst[T Word16] struct {
+	Impl16LSBitFirst[T]
+	N int
+}
+
+func (impl Slicing16LSBitFirst[T]) Update(crc T, tab []T, p []byte) T {
+	n := impl.N
+	if len(p) >= n {
+		t := sliceTables(tab, n)
+		for len(p) >= n {
+			crc ^= T(p[0]) | T(p[1])<<8
+			c := t[n-1][byte(crc)] ^ t[n-2][byte(crc>>8)]
+			for i := 2; i < n; i++ {
+				c ^= t[n-1-i][p[i]]
+			}
+			crc = c
+			p = p[n:]
+		}
+	}
+	return impl.Impl16LSBitFirst.Update(crc, tab, p)
+}
+
+type Slicing32[T Word32] struct {
+	Impl32[T]
+	N int
+}
+
+func (impl Slicing32[T]) Update(crc T, tab []T, p []byte) T {
+	n := impl.N
+	if len(p) >= n {
+		t := sliceTables(tab, n)
+		for len(p) >= n {
+			crc ^= T(p[0])<<24 | T(p[1])<<16 | T(p[2])<<8 | T(p[3])
+			c := t[n-1][byte(crc>>24)] ^ t[n-2][byte(crc>>16)] ^
+				t[n-3][byte(crc>>8)] ^ t[n-4][byte(crc)]
+			for i := 4; i < n; i++ {
+				c ^= t[n-1-i][p[i]]
+			}
+			crc = c
+			p = p[n:]
+		}
+	}
+	return impl.Impl32.Update(crc, tab, p)
+}
+
+type Slicing32LSBitFirst[T Word32] struct {
+	Impl32LSBitFirst[T]
+	N int
+}
+
+func (impl Slicing32LSBitFirst[T]) Update(crc T, tab []T, p []byte) T {
+	n := impl.N
+	if len(p) >= n {
+		t := sliceTables(tab, n)
+		for len(p) >= n {
+			crc ^= T(p[0]) | T(p[1])<<8 | T(p[2])<<16 | T(p[3])<<24
+			c := t[n-1][byte(crc)] ^ t[n-2][byte(crc>>8)] ^
+				t[n-3][byte(crc>>16)] ^ t[n-4][byte(crc>>24)]
+			for i := 4; i < n; i++ {
+				c ^= t[n-1-i][p[i]]
+			}
+			crc = c
+			p = p[n:]
+		}
+	}
+	return impl.Impl32LSBitFirst.Update(crc, tab, p)
+}
+
+type Slicing64[T Word64] struct {
+	Impl64[T]
+	N int
+}
+
+func (impl Slicing64[T]) Update(crc T, tab []T, p []byte) T {
+	n := impl.N
+	if len(p) >= n {
+		t := sliceTables(tab, n)
+		for len(p) >= n {
+			crc ^= T(p[0])<<56 | T(p[1])<<48 | T(p[2])<<40 | T(p[3])<<32 |
+				T(p[4])<<24 | T(p[5])<<16 | T(p[6])<<8 | T(p[7])
+			c := t[n-1][byte(crc>>56)] ^ t[n-2][byte(crc>>48)] ^
+				t[n-3][byte(crc>>40)] ^ t[n-4][byte(crc>>32)] ^
+				t[n-5][byte(crc>>24)] ^ t[n-6][byte(crc>>16)] ^
+				t[n-7][byte(crc>>8)] ^ t[n-8][byte(crc)]
+			for i := 8; i < n; i++ {
+				c ^= t[n-1-i][p[i]]
+			}
+			crc = c
+			p = p[n:]
+		}
+	}
+	return impl.Impl64.Update(crc, tab, p)
+}
+
+type Slicing64LSBitFirst[T Word64] struct {
+	Impl64LSBitFirst[T]
+	N int
+}
+
+func (impl Slicing64LSBitFirst[T]) Update(crc T, tab []T, p []byte) T {
+	n := impl.N
+	if len(p) >= n {
+		t := sliceTables(tab, n)
+		for len(p) >= n {
+			crc ^= T(p[0]) | T(p[1])<<8 | T(p[2])<<16 | T(p[3])<<24 |
+				T(p[4])<<32 | T(p[5])<<40 | T(p[6])<<48 | T(p[7])<<56
+			c := t[n-1][byte(crc)] ^ t[n-2][byte(crc>>8)] ^
+				t[n-3][byte(crc>>16)] ^ t[n-4][byte(crc>>24)] ^
+				t[n-5][byte(crc>>32)] ^ t[n-6][byte(crc>>40)] ^
+				t[n-7][byte(crc>>48)] ^ t[n-8][byte(crc>>56)]
+			for i := 8; i < n; i++ {
+				c ^= t[n-1-i][p[i]]
+			}
+			crc = c
+			p = p[n:]
+		}
+	}
+	return impl.Impl64LSBitFirst.Update(crc, tab, p)
+}
+
+// sliceTables splits tab into n tables of 256 entries.
+func sliceTables[T Word](tab []T, n int) *[16]*[256]T {
+	var t [16]*[256]T
+	for i := 0; i < n; i++ {
+		t[i] = (*[256]T)(tab[i*256:])
+	}
+	return &t
+}
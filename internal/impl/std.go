@@ -0,0 +1,30 @@
+package impl
+
+import (
+	"hash/crc32"
+	"hash/crc64"
+)
+
+// Std32 delegates processing of bytes to package hash/crc32,
+// which makes use of hardware acceleration for some polynomials.
+// The table passed to Update is ignored.
+type Std32 struct {
+	Impl32LSBitFirst[uint32]
+	Tab *crc32.Table
+}
+
+func (impl Std32) Update(crc uint32, _ []uint32, p []byte) uint32 {
+	// crc32.Update inverts the crc before and after processing
+	return ^crc32.Update(^crc, impl.Tab, p)
+}
+
+// Std64 delegates processing of bytes to package hash/crc64.
+// The table passed to Update is ignored.
+type Std64 struct {
+	Impl64LSBitFirst[uint64]
+	Tab *crc64.Table
+}
+
+func (impl Std64) Update(crc uint64, _ []uint64, p []byte) uint64 {
+	return ^crc64.Update(^crc, impl.Tab, p)
+}
@@ -25,6 +25,20 @@ type Model[T Word] struct {
 	// has the effect of an initial value with all bits set.
 	InitialInvert bool
 
+	// RefIn and RefOut correspond to the parameters of the same names
+	// of the Rocksoft™ model, as used in the RevEng CRC catalogue.
+	// If RefIn is set, input bytes are processed lsbit-first, using
+	// the reversed form of Poly, and Initial is interpreted as a value
+	// in normal form, which will be reflected before use.
+	// If RefOut is set, the crc value will be reflected
+	// before the final XOR-ing is applied.
+	//
+	// If Poly is specified in reversed form, both input and output
+	// are reflected, regardless of the values of RefIn and RefOut,
+	// and Initial is used unchanged.
+	RefIn  bool
+	RefOut bool
+
 	// FinalInvert may be set to true, if a crc value shall
 	// be inverted before being returned as checksum.
 	// Alternatively, FinalXOR could be set to a value with all bits set
@@ -46,7 +60,26 @@ func (m *Model[T]) MakeTable() []T {
 	if m.Table != nil {
 		return m.Table
 	}
-	return m.Poly.MakeTable()
+	return m.procPoly().MakeTable()
+}
+
+// procPoly returns the polynomial in the representation
+// used to process input bytes.
+func (m *Model[T]) procPoly() *Poly[T] {
+	if m.RefIn {
+		return m.Poly.ReversedForm()
+	}
+	return m.Poly
+}
+
+// reflectsIn reports whether input bytes are processed lsbit-first.
+func (m *Model[T]) reflectsIn() bool {
+	return m.Poly.Reversed || m.RefIn
+}
+
+// reflectsOut reports whether the crc value is output in reflected form.
+func (m *Model[T]) reflectsOut() bool {
+	return m.Poly.Reversed || m.RefOut
 }
 
 func (m *Model[T]) initVal() T {
@@ -54,9 +87,26 @@ func (m *Model[T]) initVal() T {
 	if m.InitialInvert {
 		crc = ^crc & m.Poly.mask()
 	}
+	if m.RefIn && !m.Poly.Reversed {
+		crc = reverseBits(crc, m.Poly.Width)
+	}
 	return crc
 }
+
 func (m *Model[T]) finalize(crc T) T {
+	return m.finalXOR(m.reflectOut(crc))
+}
+
+// reflectOut converts a crc value from the representation
+// used while processing input into the output representation.
+func (m *Model[T]) reflectOut(crc T) T {
+	if m.reflectsIn() != m.reflectsOut() {
+		return reverseBits(crc, m.Poly.Width)
+	}
+	return crc
+}
+
+func (m *Model[T]) finalXOR(crc T) T {
 	if m.FinalInvert {
 		return ^crc & m.Poly.mask()
 	}
@@ -66,6 +116,27 @@ func (m *Model[T]) finalize(crc T) T {
 	return crc
 }
 
+// Residue returns the residue of the Model, as listed in the
+// RevEng CRC catalogue: the content of the register after processing
+// a codeword, i.e. some data followed by its checksum,
+// in output representation, but before the final XOR-ing is applied.
+// It does not depend on the initial value.
+// It is calculated by processing the final XOR value bitwise,
+// in the msbit-first representation of the register,
+// starting with a zero register.
+func (m *Model[T]) Residue() T {
+	p := m.Poly.msbitFirst()
+	x := m.finalXOR(0)
+	if m.reflectsOut() {
+		x = reverseBits(x, p.Width)
+	}
+	r := UpdateBitwise(p, x, T(0), p.Width)
+	if m.reflectsOut() {
+		r = reverseBits(r, p.Width)
+	}
+	return r
+}
+
 // Checksum returns the CRC checksum calculated
 // over the bytes in the data slice.
 func (m *Model[T]) Checksum(data []byte) T {
@@ -88,15 +159,48 @@ type Inst[T Word] struct {
 }
 
 // NewInst returns a new instance of the Model.
+//
+// If the Model's polynomial, used in reflected form, is one of those
+// supported by packages hash/crc32 (IEEE, Castagnoli, Koopman)
+// or hash/crc64 (ISO, ECMA), processing of bytes is delegated
+// to the standard library, which for some polynomials is
+// hardware accelerated. Initial values and final XOR-ing
+// are handled independently, so that any Model using one of these
+// polynomials benefits. This does not apply if the Model specifies
+// a custom Table, or if options WithSlicing, if not ignored,
+// WithFolding(true), WithSwappedInputNibbles, or WithoutStdlib are used.
 func (m *Model[T]) New(opts ...InstOption) *Inst[T] {
 	var conf instConf
 	for _, o := range opts {
 		o(&conf)
 	}
 
+	poly := m.procPoly()
+	impl := poly.Impl()
+	tabOpts := conf.tabOpts()
+	var slicing Impl[T]
+	if conf.slices != 0 && m.Table == nil && !conf.compSwapInputNibbles {
+		slicing = poly.slicingImpl(conf.slices)
+	}
+	if slicing == nil {
+		// WithSlicing is ignored, so it must not
+		// prevent delegation to the standard library, or folding
+		conf.slices = 0
+	}
+	if si := poly.stdImpl(); si != nil && useStdlib(m, &conf) {
+		impl = si
+	} else if m.Table == nil && !conf.compSwapInputNibbles {
+		if slicing != nil {
+			impl = slicing
+			tabOpts = append(tabOpts, WithSlices(conf.slices))
+		}
+		if useFolding(m, &conf) {
+			impl = poly.foldingImpl(impl, !conf.noCLMUL)
+		}
+	}
 	tab := m.Table
 	if tab == nil {
-		tab = m.Poly.MakeTable(conf.tabOpts()...)
+		tab = poly.MakeTable(tabOpts...)
 	}
 	adjustCRC := func(crc T) T {
 		return crc
@@ -110,7 +214,7 @@ func (m *Model[T]) New(opts ...InstOption) *Inst[T] {
 		crc:   adjustCRC(m.initVal()),
 		tab:   tab,
 		model: m,
-		impl:  m.Poly.Impl(),
+		impl:  impl,
 
 		conf:      &conf,
 		adjustCRC: adjustCRC,
@@ -122,6 +226,10 @@ type InstOption func(*instConf)
 type instConf struct {
 	appendSumSkipFinalXOR bool
 	compSwapInputNibbles  bool
+	slices                int
+	folding               int
+	noStdlib              bool
+	noCLMUL               bool
 }
 
 func (c *instConf) tabOpts() []TableOption {
@@ -151,11 +259,41 @@ func WithSwappedInputNibbles() InstOption {
 	}
 }
 
+// WithSlicing selects a slicing-by-n implementation, processing
+// n bytes at once, using n lookup tables, instead of the default
+// byte-wise implementation. N may be one of 4, 8, or 16, if n is not less
+// than the size of the word type in bytes. Slicing-by-n is available
+// for polynomials of 16-, 32-, and 64-bit word types, in reversed form,
+// or in normal form, if the polynomial's width equals the word size.
+// In other cases, for other values of n, and if the Model specifies
+// a custom Table, the option is ignored, and the instance uses
+// the default implementation.
+func WithSlicing(n int) InstOption {
+	return func(c *instConf) {
+		if n != 4 && n != 8 && n != 16 {
+			n = 0
+		}
+		c.slices = n
+	}
+}
+
 // Reset sets the instance back to its initial state.
 func (inst *Inst[T]) Reset() {
 	inst.crc = inst.adjustCRC(inst.model.initVal())
 }
 
+// reg returns the content of the register, in the representation
+// used while processing input.
+func (inst *Inst[T]) reg() T {
+	return inst.adjustCRC(inst.crc)
+}
+
+// setReg sets the register to crc, which is expected
+// in the representation used while processing input.
+func (inst *Inst[T]) setReg(crc T) {
+	inst.crc = inst.adjustCRC(crc)
+}
+
 // Write implements an io.Writer to add bytes to the crc.
 func (inst *Inst[T]) Write(p []byte) (n int, err error) {
 	inst.crc = inst.impl.Update(inst.crc, inst.tab, p)
@@ -177,16 +315,28 @@ func (inst *Inst[T]) Sum() T {
 // the resulting slice. If AppendSumXORed is set, it will apply,
 // if specified, the final inversion or final xor value before appending the checksum,
 // otherwise this step will be skipped.
+// If input bytes are processed lsbit-first, all bytes of T are appended
+// in little-endian order. Otherwise the checksum is appended in big-endian
+// order; if the polynomial is narrower than T, only the minimum number
+// of bytes required to hold Width bits is appended, with the checksum
+// aligned to the most significant bit of the first byte, so that
+// e.g. a 3-bit checksum of 0b100 is appended as the byte 0b1000_0000,
+// and a 12-bit checksum of 0xf5b as the bytes 0xf5, 0xb0.
+// [Inst.AppendSumAs] allows other layouts to be specified.
 // Calling AppendSum does not change the instance's current state.
 func (inst *Inst[T]) AppendSum(in []byte) []byte {
-	crc := inst.adjustCRC(inst.crc)
+	crc := inst.model.reflectOut(inst.adjustCRC(inst.crc))
 	if !inst.conf.appendSumSkipFinalXOR {
-		crc = inst.model.finalize(crc)
+		crc = inst.model.finalXOR(crc)
 	}
 	return inst.impl.Append(in, crc)
 }
 
 // Table exposes the lookup table used by the instance.
 func (inst *Inst[T]) Table() []T {
+	if inst.conf.slices > 1 && len(inst.tab) > 256 {
+		// the first of the slicing-by-n tables
+		return inst.tab[:256]
+	}
 	return inst.tab
 }
@@ -1,12 +1,23 @@
 package crcutil_test
 
 import (
+	"bytes"
 	_ "embed"
 	"testing"
 
 	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc12"
+	"github.com/knieriem/crcutil/crc15"
+	"github.com/knieriem/crcutil/crc24"
+	crc3cat "github.com/knieriem/crcutil/crc3"
+	crc32cat "github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/crc40"
+	"github.com/knieriem/crcutil/crc64"
+	"github.com/knieriem/crcutil/crc7"
 	"github.com/knieriem/crcutil/poly32"
+	"github.com/knieriem/crcutil/poly64"
 	"hash/crc32"
+	stdcrc64 "hash/crc64"
 )
 
 var (
@@ -33,3 +44,242 @@ func TestComparePolyIEEEAgainstStd(t *testing.T) {
 		t.Fatalf("ieee checksum does not match checksum from stdlib: %08x vs. %08x", sum, stdSum)
 	}
 }
+
+func TestCompareCRC64AgainstStd(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		model *crc64.Model
+		tab   *stdcrc64.Table
+	}{
+		{"XZ", crc64.XZ, stdcrc64.MakeTable(stdcrc64.ECMA)},
+		{"GoISO", crc64.GoISO, stdcrc64.MakeTable(stdcrc64.ISO)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sum := tc.model.Checksum(ieeeData)
+			stdSum := stdcrc64.Checksum(ieeeData, tc.tab)
+			if sum != stdSum {
+				t.Fatalf("checksum does not match checksum from stdlib: %016x vs. %016x", sum, stdSum)
+			}
+		})
+	}
+}
+
+var checkData = []byte("123456789")
+
+func TestCRC64CheckValues(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		model *crc64.Model
+		check uint64
+	}{
+		{"CRC-64/ECMA-182", crc64.ECMA182, 0x6c40df5f0b497347},
+		{"CRC-64/GO-ISO", crc64.GoISO, 0xb90956c775a41001},
+		{"CRC-64/XZ", crc64.XZ, 0x995dc9bbdf1939fa},
+		{"CRC-40/GSM", crc40.GSM, 0xd4164fc646},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if sum := tc.model.Checksum(checkData); sum != tc.check {
+				t.Errorf("check value mismatch: want %#016x, got %#016x", tc.check, sum)
+			}
+		})
+	}
+}
+
+type checkCase[T crcutil.Word] struct {
+	name  string
+	model *crcutil.Model[T]
+	check T
+}
+
+// testCheckValues verifies the check values of models,
+// using instances created with the specified options.
+func testCheckValues[T crcutil.Word](t *testing.T, cases []checkCase[T], opts ...crcutil.InstOption) {
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			inst := tc.model.New(opts...)
+			inst.Update(checkData)
+			if sum := inst.Sum(); sum != tc.check {
+				t.Errorf("check value mismatch: want %#x, got %#x", tc.check, sum)
+			}
+		})
+	}
+}
+
+// TestNarrowNormalForm verifies check values of catalogued algorithms
+// using polynomials in normal form that are narrower than their word type,
+// also if specified using word types wider than required.
+func TestNarrowNormalForm(t *testing.T) {
+	testCheckValues(t, []checkCase[uint8]{
+		{"CRC-3/GSM", &crcutil.Model[uint8]{
+			Poly:     &crcutil.Poly[uint8]{Word: 0x3, Width: 3},
+			FinalXOR: 0x7,
+		}, 0x4},
+		{"CRC-5/EPC-C1G2", &crcutil.Model[uint8]{
+			Poly:    &crcutil.Poly[uint8]{Word: 0x09, Width: 5},
+			Initial: 0x09,
+		}, 0x00},
+		{"CRC-6/CDMA2000-A", &crcutil.Model[uint8]{
+			Poly:          &crcutil.Poly[uint8]{Word: 0x27, Width: 6},
+			InitialInvert: true,
+		}, 0x0d},
+		{"CRC-7/UMTS", &crcutil.Model[uint8]{
+			Poly: &crcutil.Poly[uint8]{Word: 0x45, Width: 7},
+		}, 0x61},
+	})
+	testCheckValues(t, []checkCase[uint16]{
+		{"CRC-8/SMBUS/uint16", &crcutil.Model[uint16]{
+			Poly: &crcutil.Poly[uint16]{Word: 0x07, Width: 8},
+		}, 0xf4},
+		{"CRC-12/DECT", &crcutil.Model[uint16]{
+			Poly: &crcutil.Poly[uint16]{Word: 0x80f, Width: 12},
+		}, 0xf5b},
+		{"CRC-15/CAN", &crcutil.Model[uint16]{
+			Poly: &crcutil.Poly[uint16]{Word: 0x4599, Width: 15},
+		}, 0x059e},
+	})
+	testCheckValues(t, []checkCase[uint32]{
+		{"CRC-6/CDMA2000-A/uint32", &crcutil.Model[uint32]{
+			Poly:          &crcutil.Poly[uint32]{Word: 0x27, Width: 6},
+			InitialInvert: true,
+		}, 0x0d},
+		{"CRC-17/CAN-FD", &crcutil.Model[uint32]{
+			Poly: &crcutil.Poly[uint32]{Word: 0x1685b, Width: 17},
+		}, 0x04f03},
+		{"CRC-21/CAN-FD", &crcutil.Model[uint32]{
+			Poly: &crcutil.Poly[uint32]{Word: 0x102899, Width: 21},
+		}, 0x0ed841},
+		{"CRC-24/OPENPGP", &crcutil.Model[uint32]{
+			Poly:    &crcutil.Poly[uint32]{Word: 0x864cfb, Width: 24},
+			Initial: 0xb704ce,
+		}, 0x21cf02},
+		{"CRC-31/PHILIPS", &crcutil.Model[uint32]{
+			Poly:          &crcutil.Poly[uint32]{Word: 0x04c11db7, Width: 31},
+			InitialInvert: true,
+			FinalInvert:   true,
+		}, 0x0ce9e46c},
+	})
+	testCheckValues(t, []checkCase[uint64]{
+		{"CRC-31/PHILIPS/uint64", &crcutil.Model[uint64]{
+			Poly:          &crcutil.Poly[uint64]{Word: 0x04c11db7, Width: 31},
+			InitialInvert: true,
+			FinalInvert:   true,
+		}, 0x0ce9e46c},
+		{"CRC-40/GSM", crc40.GSM, 0xd4164fc646},
+	})
+}
+
+// TestAppendSumNarrow verifies the bytes appended by AppendSum
+// for the check values of catalogued algorithms using polynomials
+// in normal form that are narrower than their word type.
+func TestAppendSumNarrow(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		sum  []byte
+		want []byte
+	}{
+		{"CRC-3/GSM", appendCheckSum(crc3cat.GSM), []byte{0x80}},
+		{"CRC-7/UMTS", appendCheckSum(crc7.UMTS), []byte{0xc2}},
+		{"CRC-8/SMBUS/uint16", appendCheckSum(&crcutil.Model[uint16]{
+			Poly: &crcutil.Poly[uint16]{Word: 0x07, Width: 8},
+		}), []byte{0xf4}},
+		{"CRC-12/DECT", appendCheckSum(crc12.DECT), []byte{0xf5, 0xb0}},
+		{"CRC-15/CAN", appendCheckSum(crc15.CAN), []byte{0x0b, 0x3c}},
+		{"CRC-24/OPENPGP", appendCheckSum(crc24.OpenPGP), []byte{0x21, 0xcf, 0x02}},
+		{"CRC-40/GSM", appendCheckSum(crc40.GSM), []byte{0xd4, 0x16, 0x4f, 0xc6, 0x46}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if !bytes.Equal(tc.sum, tc.want) {
+				t.Errorf("want % x, got % x", tc.want, tc.sum)
+			}
+		})
+	}
+}
+
+// appendCheckSum returns the bytes appended by AppendSum
+// after processing the check input using m.
+func appendCheckSum[T crcutil.Word](m *crcutil.Model[T]) []byte {
+	inst := m.New()
+	inst.Update(checkData)
+	return inst.AppendSum(nil)
+}
+
+// TestRefInRefOut verifies check values of catalogued algorithms
+// specified literally using the parameters of the Rocksoft™ model.
+func TestRefInRefOut(t *testing.T) {
+	testCheckValues(t, []checkCase[uint16]{
+		{"CRC-12/UMTS", &crcutil.Model[uint16]{
+			Poly:   &crcutil.Poly[uint16]{Word: 0x80f, Width: 12},
+			RefOut: true,
+		}, 0xdaf},
+		{"CRC-16/ARC", &crcutil.Model[uint16]{
+			Poly:   &crcutil.Poly[uint16]{Word: 0x8005, Width: 16},
+			RefIn:  true,
+			RefOut: true,
+		}, 0xbb3d},
+		{"CRC-16/RIELLO", &crcutil.Model[uint16]{
+			Poly:    &crcutil.Poly[uint16]{Word: 0x1021, Width: 16},
+			Initial: 0xb2aa,
+			RefIn:   true,
+			RefOut:  true,
+		}, 0x63d0},
+	})
+	testCheckValues(t, []checkCase[uint32]{
+		{"CRC-24/BLE", &crcutil.Model[uint32]{
+			Poly:    &crcutil.Poly[uint32]{Word: 0x00065b, Width: 24},
+			Initial: 0x555555,
+			RefIn:   true,
+			RefOut:  true,
+		}, 0xc25a56},
+		{"CRC-32/ISO-HDLC", &crcutil.Model[uint32]{
+			Poly:          poly32.IEEE,
+			InitialInvert: true,
+			RefIn:         true,
+			RefOut:        true,
+			FinalInvert:   true,
+		}, 0xcbf43926},
+	})
+}
+
+// TestStdlibDelegation verifies that instances delegating
+// to the standard library, and instances using the generic
+// implementations, calculate the expected check values, also for
+// models with non-default initial values and final XOR-ing.
+func TestStdlibDelegation(t *testing.T) {
+	models32 := []checkCase[uint32]{
+		{"IEEE", ieee, 0xcbf43926},
+		{"CRC-32/ISO-HDLC", crc32cat.ISOHDLC, 0xcbf43926},
+		{"CRC-32/ISCSI", crc32cat.ISCSI, 0xe3069283},
+		{"CRC-32/JAMCRC", crc32cat.JAMCRC, 0x340bc6d9},
+		{"Koopman", &crcutil.Model[uint32]{
+			Poly:    poly32.New(0x741b8cd7).ReversedForm(),
+			Initial: 0x12345678,
+		}, 0x5473ef3e},
+		{"IEEE/RefIn", &crcutil.Model[uint32]{
+			Poly:     poly32.IEEE,
+			RefIn:    true,
+			FinalXOR: 0x5a5a5a5a,
+		}, 0x4beee5ee},
+	}
+	models64 := []checkCase[uint64]{
+		{"CRC-64/XZ", crc64.XZ, 0x995dc9bbdf1939fa},
+		{"CRC-64/GO-ISO", crc64.GoISO, 0xb90956c775a41001},
+		{"ECMA", &crcutil.Model[uint64]{
+			Poly:    poly64.ECMA,
+			RefIn:   true,
+			RefOut:  true,
+			Initial: 1,
+		}, 0xbb670f2e342cac7f},
+	}
+	for _, tc := range []struct {
+		name string
+		opts []crcutil.InstOption
+	}{
+		{"stdlib", nil},
+		{"generic", []crcutil.InstOption{crcutil.WithoutStdlib()}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testCheckValues(t, models32, tc.opts...)
+			testCheckValues(t, models64, tc.opts...)
+		})
+	}
+}
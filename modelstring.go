@@ -0,0 +1,219 @@
+package crcutil
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// ErrMismatch is reported, wrapped into a [ParseError],
+// if a check value or residue specified in a model string
+// does not match the value calculated from the model's parameters.
+var ErrMismatch = errors.New("value does not match the calculated one")
+
+// A ParseError describes a problem found while parsing a model string.
+type ParseError struct {
+	Param string // name of the parameter concerned, if any
+	Value string // value of the parameter, if any
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	s := "crcutil: "
+	if e.Param != "" {
+		s += "parameter " + e.Param + ": "
+	}
+	if e.Value != "" {
+		s += strconv.Quote(e.Value) + ": "
+	}
+	return s + e.Err.Error()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseModel parses a model specified as a string
+// in the format used by CRC RevEng, like
+//
+//	width=16 poly=0x1021 init=0xffff refin=false refout=false xorout=0x0000 check=0x29b1 residue=0x0000 name="CRC-16/IBM-3740"
+//
+// Parameters width and poly are required; init and xorout
+// default to zero, refin and refout to false.
+// If check or residue are present, they are compared with
+// the values calculated from the model; a mismatch results
+// in an error wrapping [ErrMismatch].
+func ParseModel(s string) (*AnyModel, error) {
+	m := new(AnyModel)
+	var check, residue *uint64
+	seen := make(map[string]bool)
+
+	rest := strings.TrimSpace(s)
+	for rest != "" {
+		key, val, err := nextParam(&rest)
+		if err != nil {
+			return nil, err
+		}
+		if seen[key] {
+			return nil, &ParseError{Param: key, Err: errors.New("specified more than once")}
+		}
+		seen[key] = true
+
+		var perr error
+		switch key {
+		case "width":
+			m.Width, perr = strconv.Atoi(val)
+			if perr == nil && (m.Width < 1 || m.Width > 64) {
+				perr = errors.New("out of range")
+			}
+		case "poly":
+			m.Poly, perr = parseHex(val)
+		case "init":
+			m.Init, perr = parseHex(val)
+		case "xorout":
+			m.XorOut, perr = parseHex(val)
+		case "refin":
+			m.RefIn, perr = strconv.ParseBool(val)
+		case "refout":
+			m.RefOut, perr = strconv.ParseBool(val)
+		case "check":
+			check = new(uint64)
+			*check, perr = parseHex(val)
+		case "residue":
+			residue = new(uint64)
+			*residue, perr = parseHex(val)
+		case "name":
+			m.Name = val
+		default:
+			perr = errors.New("unknown parameter")
+		}
+		if perr != nil {
+			if ne, ok := perr.(*strconv.NumError); ok {
+				perr = ne.Err
+			}
+			return nil, &ParseError{Param: key, Value: val, Err: perr}
+		}
+	}
+	for _, key := range []string{"width", "poly"} {
+		if !seen[key] {
+			return nil, &ParseError{Param: key, Err: errors.New("missing")}
+		}
+	}
+	am, err := m.get()
+	if err != nil {
+		return nil, &ParseError{Err: err}
+	}
+	if check != nil {
+		if v := am.checksum([]byte(CheckInput)); v != *check {
+			return nil, m.mismatch("check", *check, v)
+		}
+	}
+	if residue != nil {
+		if v := am.residue(); v != *residue {
+			return nil, m.mismatch("residue", *residue, v)
+		}
+	}
+	return m, nil
+}
+
+func (m *AnyModel) mismatch(param string, declared, computed uint64) error {
+	return &ParseError{
+		Param: param,
+		Value: m.formatHex(declared),
+		Err:   fmt.Errorf("%w: %s", ErrMismatch, m.formatHex(computed)),
+	}
+}
+
+// nextParam splits the next key=value pair from the string
+// pointed to by s, and advances s.
+func nextParam(s *string) (key, val string, err error) {
+	str := *s
+	i := strings.IndexByte(str, '=')
+	if i == -1 {
+		return "", "", &ParseError{Value: str, Err: errors.New("key=value pair expected")}
+	}
+	key = strings.ToLower(str[:i])
+	if key == "" || strings.ContainsAny(key, " \t") {
+		return "", "", &ParseError{Value: str[:i], Err: errors.New("invalid key")}
+	}
+	str = str[i+1:]
+	if strings.HasPrefix(str, `"`) {
+		q, err := strconv.QuotedPrefix(str)
+		if err != nil {
+			return "", "", &ParseError{Param: key, Value: str, Err: err}
+		}
+		val, _ = strconv.Unquote(q)
+		str = str[len(q):]
+		if str != "" && str[0] != ' ' && str[0] != '\t' {
+			return "", "", &ParseError{Param: key, Value: q + str, Err: errors.New("separator missing")}
+		}
+	} else {
+		i = strings.IndexAny(str, " \t")
+		if i == -1 {
+			i = len(str)
+		}
+		val, str = str[:i], str[i:]
+	}
+	*s = strings.TrimSpace(str)
+	return key, val, nil
+}
+
+func parseHex(s string) (uint64, error) {
+	h := strings.TrimPrefix(strings.ToLower(s), "0x")
+	if h == "" {
+		return 0, strconv.ErrSyntax
+	}
+	return strconv.ParseUint(h, 16, 64)
+}
+
+func (m *AnyModel) formatHex(v uint64) string {
+	return fmt.Sprintf("%#0*x", (m.Width+3)/4, v)
+}
+
+// String returns the model's parameters in the format
+// accepted by [ParseModel], including the check value and residue.
+// If the parameters of the model are not valid,
+// check value and residue are omitted.
+func (m *AnyModel) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "width=%d poly=%s init=%s refin=%t refout=%t xorout=%s",
+		m.Width,
+		m.formatHex(m.Poly),
+		m.formatHex(m.Init),
+		m.RefIn, m.RefOut,
+		m.formatHex(m.XorOut))
+	if am, err := m.get(); err == nil {
+		fmt.Fprintf(&b, " check=%s residue=%s",
+			m.formatHex(am.checksum([]byte(CheckInput))),
+			m.formatHex(am.residue()))
+	}
+	if m.Name != "" {
+		fmt.Fprintf(&b, " name=%q", m.Name)
+	}
+	return b.String()
+}
+
+// FormatModel returns the parameters of a Model in the format
+// accepted by [ParseModel]. If name is not empty, it will be
+// included into the result.
+func FormatModel[T Word](m *Model[T], name string) string {
+	am := NewAnyModel(m)
+	am.Name = name
+	return am.String()
+}
+
+// ModelFromAny returns a Model equivalent to the AnyModel,
+// using a word type T, which must be wide enough to hold
+// values of the model's width.
+func ModelFromAny[T Word](m *AnyModel) (*Model[T], error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	var x T
+	if m.Width > 8*int(unsafe.Sizeof(x)) {
+		return nil, errors.New("crcutil: word type too small for model width")
+	}
+	return makeModelOf[T](m).Model, nil
+}
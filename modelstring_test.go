@@ -0,0 +1,101 @@
+package crcutil_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc16"
+)
+
+func ExampleParseModel() {
+	m, err := crcutil.ParseModel(`width=16 poly=0x1021 init=0xffff refin=false refout=false xorout=0x0000 check=0x29b1 residue=0x0000 name="CRC-16/IBM-3740"`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	sum, err := m.Checksum([]byte(crcutil.CheckInput))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%s %#04x\n", m.Name, sum)
+	// Output: CRC-16/IBM-3740 0x29b1
+}
+
+func ExampleFormatModel() {
+	fmt.Println(crcutil.FormatModel(crc16.Modbus, "CRC-16/MODBUS"))
+	// Output: width=16 poly=0x8005 init=0xffff refin=true refout=true xorout=0x0000 check=0x4b37 residue=0x0000 name="CRC-16/MODBUS"
+}
+
+// TestModelStringRoundTrip formats all registered models,
+// and parses the results back.
+func TestModelStringRoundTrip(t *testing.T) {
+	for _, e := range crcutil.Registered() {
+		s := e.AnyModel().String()
+		m, err := crcutil.ParseModel(s)
+		if err != nil {
+			t.Errorf("%s: %v", e.Name, err)
+			continue
+		}
+		if m.Name != e.Name {
+			t.Errorf("%s: name mismatch: %q", e.Name, m.Name)
+		}
+		if s1 := m.String(); s1 != s {
+			t.Errorf("%s: round trip mismatch:\n\t%s\n\t%s", e.Name, s, s1)
+		}
+	}
+}
+
+func TestParseModelErrors(t *testing.T) {
+	for _, tc := range []struct {
+		s     string
+		param string
+	}{
+		{"poly=0x07", "width"},
+		{"width=8", "poly"},
+		{"width=8 poly=0x07 refin=maybe", "refin"},
+		{"width=8 poly=0x0g", "poly"},
+		{"width=65 poly=0x07", "width"},
+		{"width=8 poly=0x07 width=8", "width"},
+		{"width=8 poly=0x07 foo=1", "foo"},
+		{"width=8 poly=0x07 check=0xf5", "check"},
+		{"width=8 poly=0x07 xorout=0x55 residue=0x00", "residue"},
+		{`width=8 poly=0x07 name="CRC-8`, "name"},
+		{"width=8 poly", ""},
+		{"width=8 poly=0x107", ""},
+	} {
+		_, err := crcutil.ParseModel(tc.s)
+		var perr *crcutil.ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%q: ParseError expected, got %v", tc.s, err)
+			continue
+		}
+		if perr.Param != tc.param {
+			t.Errorf("%q: unexpected parameter in error: %v", tc.s, err)
+		}
+	}
+
+	_, err := crcutil.ParseModel("width=8 poly=0x07 check=0xf5")
+	if !errors.Is(err, crcutil.ErrMismatch) {
+		t.Errorf("ErrMismatch expected, got %v", err)
+	}
+}
+
+func TestModelFromAny(t *testing.T) {
+	am, err := crcutil.ParseModel("width=16 poly=0x8005 init=0xffff refin=true refout=true xorout=0x0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := crcutil.ModelFromAny[uint32](am)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum := m.Checksum([]byte(crcutil.CheckInput)); sum != 0x4b37 {
+		t.Errorf("check value mismatch: %#04x", sum)
+	}
+	if _, err := crcutil.ModelFromAny[uint8](am); err == nil {
+		t.Error("conversion to a type too small succeeded")
+	}
+}
@@ -0,0 +1,139 @@
+package crcutil
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// DefaultChunkSize is the default size of the chunks
+// processed by the workers of ChecksumParallel.
+const DefaultChunkSize = 1 << 20
+
+// ParallelOption configures ChecksumParallel.
+type ParallelOption func(*parallelConf)
+
+type parallelConf struct {
+	chunkSize int
+}
+
+// WithChunkSize sets the size of the chunks, in bytes,
+// the input is split into.
+func WithChunkSize(n int) ParallelOption {
+	return func(c *parallelConf) {
+		c.chunkSize = n
+	}
+}
+
+// ChecksumParallel returns the CRC checksum calculated over
+// size bytes read from r, using the specified number of workers,
+// each running in its own goroutine.
+// If workers is less than one, runtime.GOMAXPROCS(0) workers are used.
+// The input is split into chunks, that are processed independently;
+// the resulting crc values are merged like in [Model.Combine].
+// The result is identical to the one of Checksum called on the same data.
+//
+// If ctx is canceled, workers stop before reading the next chunk,
+// and ChecksumParallel returns the context's error.
+func (m *Model[T]) ChecksumParallel(ctx context.Context, r io.ReaderAt, size int64, workers int, opts ...ParallelOption) (T, error) {
+	conf := parallelConf{chunkSize: DefaultChunkSize}
+	for _, o := range opts {
+		o(&conf)
+	}
+	if conf.chunkSize <= 0 {
+		return 0, errors.New("crcutil: invalid chunk size")
+	}
+	if size < 0 {
+		return 0, errors.New("crcutil: negative size")
+	}
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	chunkSize := int64(conf.chunkSize)
+	nChunks := (size + chunkSize - 1) / chunkSize
+	if int64(workers) > nChunks {
+		workers = int(nChunks)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// regs receives the register values, in normal form,
+	// resulting from processing each chunk starting with a zero register.
+	regs := make([]uint64, nChunks)
+	next := make(chan int64)
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var firstErr error
+	fail := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	bufSize := chunkSize
+	if size < bufSize {
+		bufSize = size
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buf := make([]byte, bufSize)
+			inst := m.New()
+			for i := range next {
+				if ctx.Err() != nil {
+					return
+				}
+				off := i * chunkSize
+				n := chunkSize
+				if off+n > size {
+					n = size - off
+				}
+				b := buf[:n]
+				if k, err := r.ReadAt(b, off); k < len(b) {
+					if err == nil || err == io.EOF {
+						err = io.ErrUnexpectedEOF
+					}
+					fail(err)
+					return
+				}
+				inst.setReg(0)
+				inst.Update(b)
+				regs[i] = m.normalReg(inst.reg())
+			}
+		}()
+	}
+
+feed:
+	for i := int64(0); i < nChunks; i++ {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	if firstErr != nil {
+		return 0, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	g := newGF2(m.Poly)
+	shift := g.xPow(8, uint64(chunkSize))
+	crc := m.normalReg(m.initVal())
+	for i, reg := range regs {
+		if i == len(regs)-1 {
+			shift = g.xPow(8, uint64(size-int64(i)*chunkSize))
+		}
+		crc = g.mul(crc, shift) ^ reg
+	}
+	return m.regToSum(crc), nil
+}
@@ -0,0 +1,112 @@
+package crcutil_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc12"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/crc5"
+	"github.com/knieriem/crcutil/crc64"
+)
+
+// TestChecksumParallel verifies check values calculated from
+// small chunks, including a partial last one, and compares results
+// for larger data against those of the sequential calculation.
+func TestChecksumParallel(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 100000+17)
+	rnd.Read(data)
+
+	testChecksumParallel(t, crc5.Catalog, data)
+	testChecksumParallel(t, crc12.Catalog, data)
+	testChecksumParallel(t, crc16.Catalog, data)
+	testChecksumParallel(t, crc32.Catalog, data)
+	testChecksumParallel(t, crc64.Catalog, data)
+}
+
+func testChecksumParallel[T crcutil.Word](t *testing.T, cat []*crcutil.CatalogEntry[T], data []byte) {
+	ctx := context.Background()
+	for _, e := range cat {
+		t.Run(e.Name, func(t *testing.T) {
+			m := e.Model
+			for _, chunkSize := range []int{1, 2, 4, 9, 100} {
+				sum, err := m.ChecksumParallel(ctx, bytes.NewReader(checkData), int64(len(checkData)), 3, crcutil.WithChunkSize(chunkSize))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if sum != e.Check {
+					t.Errorf("chunk size %d: want %#x, got %#x", chunkSize, e.Check, sum)
+				}
+			}
+			for _, size := range []int{0, 4096, len(data)} {
+				want := m.Checksum(data[:size])
+				for _, workers := range []int{0, 1, 8} {
+					sum, err := m.ChecksumParallel(ctx, bytes.NewReader(data), int64(size), workers, crcutil.WithChunkSize(4096))
+					if err != nil {
+						t.Fatal(err)
+					}
+					if sum != want {
+						t.Errorf("size %d, %d workers: want %#x, got %#x", size, workers, want, sum)
+					}
+				}
+			}
+		})
+	}
+}
+
+// cancelingReader cancels a context on the first read,
+// and counts the reads performed.
+type cancelingReader struct {
+	r      io.ReaderAt
+	cancel context.CancelFunc
+	reads  int32
+}
+
+func (r *cancelingReader) ReadAt(p []byte, off int64) (int, error) {
+	atomic.AddInt32(&r.reads, 1)
+	r.cancel()
+	return r.r.ReadAt(p, off)
+}
+
+// TestChecksumParallelCancel verifies that workers stop
+// reading chunks once the context has been canceled.
+func TestChecksumParallelCancel(t *testing.T) {
+	data := make([]byte, 100000)
+	const workers = 1
+	for i := 0; i < 20; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		r := &cancelingReader{r: bytes.NewReader(data), cancel: cancel}
+		_, err := crc32.ISOHDLC.ChecksumParallel(ctx, r, int64(len(data)), workers, crcutil.WithChunkSize(100))
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("context.Canceled expected, got %v", err)
+		}
+		if n := atomic.LoadInt32(&r.reads); n > workers {
+			t.Fatalf("%d chunks read after cancellation, at most %d expected", n, workers)
+		}
+	}
+}
+
+func TestChecksumParallelErrors(t *testing.T) {
+	data := make([]byte, 10000)
+	m := crc32.ISOHDLC
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := m.ChecksumParallel(ctx, bytes.NewReader(data), int64(len(data)), 2, crcutil.WithChunkSize(100))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("context.Canceled expected, got %v", err)
+	}
+
+	_, err = m.ChecksumParallel(context.Background(), bytes.NewReader(data), int64(len(data))+1, 2, crcutil.WithChunkSize(100))
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("io.ErrUnexpectedEOF expected, got %v", err)
+	}
+}
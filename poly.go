@@ -6,7 +6,7 @@ import (
 
 // A Word holds the word representation of a polynomial.
 type Word interface {
-	uint8 | uint16 | uint32
+	uint8 | uint16 | uint32 | uint64
 }
 
 // Poly defines a polynomial in a specific representation.
@@ -28,6 +28,16 @@ func (p *Poly[T]) NormalForm() *Poly[T] {
 	return p
 }
 
+// msbitFirst returns the msbit-first form of the polynomial.
+// Unlike NormalForm, it keeps a reciprocal form, since tables
+// and bitwise updates process the word as written.
+func (p *Poly[T]) msbitFirst() *Poly[T] {
+	if p.Reversed {
+		p = p.reverse()
+	}
+	return p
+}
+
 // ReversedForm returns the reversed, lsbit-first form of the polynomial.
 // It returns the unchanged polynomial if it is already in its reversed form.
 func (p *Poly[T]) ReversedForm() *Poly[T] {
@@ -50,12 +60,12 @@ func (p *Poly[T]) reverse() *Poly[T] {
 // ReverseBits mirrors the lower n bits of the data value
 // within the boundaries of those lower n bits.
 func reverseBits[T Word](data T, n int) T {
-	// support polynomials up to uint32
-	// by allowing shift into bit32
-	mask := uint32((uint64(1) << n) - 1)
+	// support polynomials up to uint64;
+	// a shift by 64 results in zero, and the mask will have all bits set
+	mask := (uint64(1) << n) - 1
 
-	u := bits.Reverse32(uint32(data))
-	u >>= 32 - n
+	u := bits.Reverse64(uint64(data))
+	u >>= 64 - n
 	u &= mask
 	return T(u)
 }
@@ -77,8 +87,8 @@ func (p *Poly[T]) ReciprocalForm() *Poly[T] {
 }
 
 func (p *Poly[T]) makeReciprocal() *Poly[T] {
-	u := bits.Reverse32(uint32(p.Word))
-	if shift := 32 - p.Width - 1; shift > 0 {
+	u := bits.Reverse64(uint64(p.Word))
+	if shift := 64 - p.Width - 1; shift > 0 {
 		u >>= shift
 	} else if shift < 0 {
 		u <<= -shift
@@ -94,7 +104,7 @@ func (p *Poly[T]) makeReciprocal() *Poly[T] {
 }
 
 func (p *Poly[T]) mask() T {
-	return T((uint32(1) << p.Width) - 1)
+	return T((uint64(1) << p.Width) - 1)
 }
 
 // LSBitFirst reports whether the polynomial's representation is lsbit-first.
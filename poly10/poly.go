@@ -0,0 +1,11 @@
+package poly10
+
+import (
+	"github.com/knieriem/crcutil/poly16"
+)
+
+const N = 10
+
+func New(poly uint16) *poly16.Poly {
+	return &poly16.Poly{Word: poly, Width: N}
+}
@@ -0,0 +1,11 @@
+package poly13
+
+import (
+	"github.com/knieriem/crcutil/poly16"
+)
+
+const N = 13
+
+func New(poly uint16) *poly16.Poly {
+	return &poly16.Poly{Word: poly, Width: N}
+}
@@ -0,0 +1,11 @@
+package poly31
+
+import (
+	"github.com/knieriem/crcutil/poly32"
+)
+
+const N = 31
+
+func New(poly uint32) *poly32.Poly {
+	return &poly32.Poly{Word: poly, Width: N}
+}
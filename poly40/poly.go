@@ -0,0 +1,16 @@
+package poly40
+
+import (
+	"github.com/knieriem/crcutil/poly64"
+)
+
+const N = 40
+
+var (
+	// CRC-40-GSM: x⁴⁰ + x²⁶ + x²³ + x¹⁷ + x³ + 1
+	GSM = New(0x0004820009)
+)
+
+func New(poly uint64) *poly64.Poly {
+	return &poly64.Poly{Word: poly, Width: N}
+}
@@ -0,0 +1,11 @@
+package poly5
+
+import (
+	"github.com/knieriem/crcutil/poly8"
+)
+
+const N = 5
+
+func New(poly uint8) *poly8.Poly {
+	return &poly8.Poly{Word: poly, Width: N}
+}
@@ -0,0 +1,21 @@
+package poly64
+
+import (
+	"github.com/knieriem/crcutil"
+)
+
+type Poly = crcutil.Poly[uint64]
+
+const N = 64
+
+var (
+	// CRC-64-ECMA-182: x⁶⁴ + x⁶² + x⁵⁷ + x⁵⁵ + x⁵⁴ + x⁵³ + x⁵² + x⁴⁷ + x⁴⁶ + x⁴⁵ + x⁴⁰ + x³⁹ + x³⁸ + x³⁷ + x³⁵ + x³³ + x³² + x³¹ + x²⁹ + x²⁷ + x²⁴ + x²³ + x²² + x²¹ + x¹⁹ + x¹⁷ + x¹³ + x¹² + x¹⁰ + x⁹ + x⁷ + x⁴ + x + 1
+	ECMA = New(0x42F0E1EBA9EA3693)
+
+	// CRC-64-ISO: x⁶⁴ + x⁴ + x³ + x + 1
+	ISO = New(0x000000000000001B)
+)
+
+func New(poly uint64) *Poly {
+	return &Poly{Word: poly, Width: N}
+}
@@ -0,0 +1,259 @@
+package crcutil
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// String returns the polynomial in algebraic notation,
+// using ASCII characters only, like "x^8+x^5+x^4+1".
+// Regardless of the representation of p,
+// the polynomial is written in its normal form.
+func (p *Poly[T]) String() string {
+	return p.algebraic(false)
+}
+
+// Format implements [fmt.Formatter]. Verbs %s and %v
+// write the polynomial in algebraic notation, as returned by String;
+// if the '+' flag is set, superscript digits are used,
+// and terms are separated by spaces, like "x⁸ + x⁵ + x⁴ + 1".
+// Verb %q writes the quoted result of String.
+// Integer verbs like %x or %d format the word value
+// of the polynomial's representation.
+// The Go syntax representation is written for %#v.
+func (p *Poly[T]) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('#') {
+			fmt.Fprintf(f, "&crcutil.Poly[%T]{Word:%#x, Width:%d, Reversed:%t, Reciprocal:%t}",
+				p.Word, p.Word, p.Width, p.Reversed, p.Reciprocal)
+			return
+		}
+		fallthrough
+	case 's':
+		s := p.algebraic(f.Flag('+'))
+		if w, ok := f.Width(); ok {
+			if n := utf8.RuneCountInString(s); n < w {
+				pad := strings.Repeat(" ", w-n)
+				if f.Flag('-') {
+					s += pad
+				} else {
+					s = pad + s
+				}
+			}
+		}
+		f.Write([]byte(s))
+	case 'q':
+		f.Write([]byte(strconv.Quote(p.String())))
+	case 'b', 'o', 'O', 'd', 'x', 'X':
+		fmt.Fprintf(f, formatDirective(f, verb), p.Word)
+	default:
+		fmt.Fprintf(f, "%%!%c(*crcutil.Poly[%T]=%s)", verb, p.Word, p.String())
+	}
+}
+
+// formatDirective reconstructs the directive
+// with flags, width, and precision from f.
+func formatDirective(f fmt.State, verb rune) string {
+	d := "%"
+	for _, c := range "+-# 0" {
+		if f.Flag(int(c)) {
+			d += string(c)
+		}
+	}
+	if w, ok := f.Width(); ok {
+		d += strconv.Itoa(w)
+	}
+	if prec, ok := f.Precision(); ok {
+		d += "." + strconv.Itoa(prec)
+	}
+	return d + string(verb)
+}
+
+var superscriptDigits = []rune("⁰¹²³⁴⁵⁶⁷⁸⁹")
+
+func (p *Poly[T]) algebraic(superscript bool) string {
+	n := p.NormalForm()
+	sep := "+"
+	if superscript {
+		sep = " + "
+	}
+	var b strings.Builder
+	for i := n.Width; i >= 0; i-- {
+		if i != n.Width {
+			if (n.Word>>i)&1 == 0 {
+				continue
+			}
+			b.WriteString(sep)
+		}
+		switch i {
+		case 0:
+			b.WriteByte('1')
+		case 1:
+			b.WriteByte('x')
+		default:
+			b.WriteByte('x')
+			if !superscript {
+				b.WriteByte('^')
+				b.WriteString(strconv.Itoa(i))
+				break
+			}
+			for _, c := range strconv.Itoa(i) {
+				b.WriteRune(superscriptDigits[c-'0'])
+			}
+		}
+	}
+	return b.String()
+}
+
+// ParsePoly parses a polynomial specified in one of the following notations:
+//
+//   - algebraic notation, as returned by [Poly.String]
+//     or formatted using %+v, like "x^16+x^12+x^5+1",
+//     or "x¹⁶ + x¹² + x⁵ + 1"; the width is the degree
+//     of the highest term; the result is in normal form;
+//   - a word value in hexadecimal notation, optionally followed
+//     by a slash and the width, like "0x1021/16"; if the width
+//     is omitted, the bit size of T is assumed. The word may be prefixed by
+//     one of the representations "normal:", "reversed:", "reciprocal:",
+//     or "reversed-reciprocal:"; the default is normal;
+//   - a word value in Koopman's implicit +1 notation,
+//     prefixed with "koopman:", like "koopman:0x8810";
+//     see [FromImplicit1Notation].
+func ParsePoly[T Word](s string) (*Poly[T], error) {
+	s = strings.TrimSpace(s)
+	var x T
+	maxWidth := 8 * int(unsafe.Sizeof(x))
+
+	if strings.HasPrefix(s, "x") || s == "1" {
+		return parseAlgebraic[T](s, maxWidth)
+	}
+
+	rep := "normal"
+	if i := strings.IndexByte(s, ':'); i != -1 {
+		rep, s = strings.ToLower(s[:i]), s[i+1:]
+	}
+	width := maxWidth
+	if i := strings.IndexByte(s, '/'); i != -1 {
+		if rep == "koopman" {
+			return nil, polySyntaxError(s, "width must not be specified in koopman notation")
+		}
+		w, err := strconv.Atoi(s[i+1:])
+		if err != nil || w < 1 || w > maxWidth {
+			return nil, polySyntaxError(s[i+1:], "invalid width")
+		}
+		width, s = w, s[:i]
+	}
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return nil, polySyntaxError(s, "hexadecimal word expected")
+	}
+	v, err := strconv.ParseUint(s[2:], 16, maxWidth)
+	if err != nil {
+		return nil, polySyntaxError(s, "invalid word")
+	}
+	w := T(v)
+	if rep == "koopman" {
+		if w == 0 {
+			return nil, polySyntaxError(s, "invalid word")
+		}
+		return FromImplicit1Notation(w), nil
+	}
+	p := &Poly[T]{Word: w, Width: width}
+	if w&^p.mask() != 0 {
+		return nil, polySyntaxError(s, "word exceeds width")
+	}
+	switch rep {
+	case "normal":
+	case "reversed":
+		p.Reversed = true
+	case "reciprocal":
+		p.Reciprocal = true
+	case "reversed-reciprocal":
+		p.Reversed = true
+		p.Reciprocal = true
+	default:
+		return nil, polySyntaxError(rep, "unknown representation")
+	}
+	return p, nil
+}
+
+func parseAlgebraic[T Word](s string, maxWidth int) (*Poly[T], error) {
+	var w uint64
+	width := -1
+	for _, term := range strings.Split(s, "+") {
+		term = strings.TrimSpace(term)
+		exp, err := parseTerm(term)
+		if err != nil {
+			return nil, err
+		}
+		if exp > maxWidth {
+			return nil, polySyntaxError(term, "degree exceeds word size")
+		}
+		if exp > width {
+			if width != -1 {
+				return nil, polySyntaxError(term, "terms not in descending order")
+			}
+			width = exp
+			continue
+		}
+		if w&(1<<exp) != 0 || exp == width {
+			return nil, polySyntaxError(term, "duplicate term")
+		}
+		w |= 1 << exp
+	}
+	if width < 1 {
+		return nil, polySyntaxError(s, "degree must be at least one")
+	}
+	return &Poly[T]{Word: T(w), Width: width}, nil
+}
+
+// parseTerm returns the exponent of a term like "1", "x", "x^3", or "x³".
+func parseTerm(term string) (int, error) {
+	switch term {
+	case "1":
+		return 0, nil
+	case "x":
+		return 1, nil
+	case "":
+		return 0, polySyntaxError(term, "empty term")
+	}
+	if !strings.HasPrefix(term, "x") {
+		return 0, polySyntaxError(term, "invalid term")
+	}
+	e := term[1:]
+	if strings.HasPrefix(e, "^") {
+		e = e[1:]
+	} else {
+		var digits []byte
+		for _, c := range e {
+			d := runeIndex(superscriptDigits, c)
+			if d == -1 {
+				return 0, polySyntaxError(term, "invalid exponent")
+			}
+			digits = append(digits, byte('0'+d))
+		}
+		e = string(digits)
+	}
+	n, err := strconv.Atoi(e)
+	if err != nil || n < 0 {
+		return 0, polySyntaxError(term, "invalid exponent")
+	}
+	return n, nil
+}
+
+func runeIndex(list []rune, r rune) int {
+	for i, c := range list {
+		if c == r {
+			return i
+		}
+	}
+	return -1
+}
+
+func polySyntaxError(s, msg string) error {
+	return &ParseError{Value: s, Err: errors.New(msg)}
+}
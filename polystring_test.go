@@ -0,0 +1,90 @@
+package crcutil_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/poly16"
+	"github.com/knieriem/crcutil/poly8"
+)
+
+func ExamplePoly_String() {
+	p := poly8.DOW.ReversedForm()
+	fmt.Println(p)
+	fmt.Printf("%+v\n", p)
+	fmt.Printf("%#02x\n", p)
+	// Output:
+	// x^8+x^5+x^4+1
+	// x⁸ + x⁵ + x⁴ + 1
+	// 0x8c
+}
+
+func ExampleParsePoly() {
+	for _, s := range []string{
+		"x^16 + x^12 + x^5 + 1",
+		"x¹⁶ + x¹² + x⁵ + 1",
+		"0x1021/16",
+		"reversed:0x8408/16",
+		"koopman:0x8810",
+	} {
+		p, err := crcutil.ParsePoly[uint16](s)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Printf("%#04x\n", p.NormalForm().Word)
+	}
+	// Output:
+	// 0x1021
+	// 0x1021
+	// 0x1021
+	// 0x1021
+	// 0x1021
+}
+
+func TestPolyStringRoundTrip(t *testing.T) {
+	for _, p := range []*poly16.Poly{
+		poly16.CCITT,
+		poly16.IBM.ReversedForm(),
+		poly16.CCITT.ReciprocalForm(),
+		{Word: 0x3, Width: 3},
+		{Word: 0x1, Width: 1},
+	} {
+		for _, format := range []string{"%v", "%+v"} {
+			s := fmt.Sprintf(format, p)
+			q, err := crcutil.ParsePoly[uint16](s)
+			if err != nil {
+				t.Errorf("%q: %v", s, err)
+				continue
+			}
+			n := p.NormalForm()
+			if q.Word != n.Word || q.Width != n.Width {
+				t.Errorf("%q: parsed %#v, want %#v", s, q, n)
+			}
+		}
+	}
+}
+
+func TestParsePolyErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"x^8+x^8+1",
+		"x^4+x^8+1",
+		"x^17+1",
+		"x^a+1",
+		"x^8++1",
+		"y^8+1",
+		"0x1021/17",
+		"0x11021/16",
+		"0x1021/x",
+		"1021",
+		"inverse:0x1021",
+		"koopman:0x8810/16",
+		"koopman:0x0",
+	} {
+		if p, err := crcutil.ParsePoly[uint16](s); err == nil {
+			t.Errorf("%q: parsing succeeded: %#v", s, p)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package crcutil
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// A RegEntry is a handle to a registered Model.
+// Since the type of a Model depends on the word type,
+// a RegEntry provides width-erased access to it;
+// the typed Model may be obtained using [LookupModel],
+// or by a type assertion on the value returned by the Model method.
+type RegEntry struct {
+	Name    string
+	Aliases []string
+	Width   int
+
+	// Check and Residue are the values of the corresponding
+	// fields of the CatalogEntry that has been registered,
+	// converted to uint64.
+	Check   uint64
+	Residue uint64
+
+	model    any
+	anyModel *AnyModel
+}
+
+// Model returns the registered *Model[T].
+func (e *RegEntry) Model() any {
+	return e.model
+}
+
+// AnyModel returns a width-erased version of the registered Model.
+func (e *RegEntry) AnyModel() *AnyModel {
+	return e.anyModel
+}
+
+// Checksum returns the CRC checksum calculated over the bytes
+// in the data slice, using the registered Model.
+func (e *RegEntry) Checksum(data []byte) uint64 {
+	// registered models are derived from a Model, and always valid
+	sum, _ := e.anyModel.Checksum(data)
+	return sum
+}
+
+var registry struct {
+	sync.RWMutex
+	byName  map[string]*RegEntry
+	entries []*RegEntry
+}
+
+// Register adds a catalogue entry to the registry of models,
+// so that it can be looked up by its name, or any of its aliases.
+// Names are compared case-insensitively.
+// An error is returned if the name or one of the aliases
+// is already in use.
+//
+// The crc{n} sub-packages register the entries of their
+// Catalog variables on initialization.
+func Register[T Word](e *CatalogEntry[T]) error {
+	if e.Name == "" {
+		return errors.New("crcutil: model name missing")
+	}
+	if e.Model == nil {
+		return errors.New("crcutil: model missing: " + e.Name)
+	}
+	am := NewAnyModel(e.Model)
+	am.Name = e.Name
+	re := &RegEntry{
+		Name:     e.Name,
+		Aliases:  e.Aliases,
+		Width:    am.Width,
+		Check:    uint64(e.Check),
+		Residue:  uint64(e.Residue),
+		model:    e.Model,
+		anyModel: am,
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	if registry.byName == nil {
+		registry.byName = make(map[string]*RegEntry)
+	}
+	keys := make([]string, 0, 1+len(e.Aliases))
+	for _, name := range append([]string{e.Name}, e.Aliases...) {
+		k := strings.ToUpper(name)
+		if _, dup := registry.byName[k]; dup {
+			return errors.New("crcutil: model name already registered: " + name)
+		}
+		keys = append(keys, k)
+	}
+	for _, k := range keys {
+		registry.byName[k] = re
+	}
+	registry.entries = append(registry.entries, re)
+	return nil
+}
+
+// RegisterModel registers a custom Model under the specified
+// name and aliases. Check value and residue are calculated
+// from the Model.
+func RegisterModel[T Word](name string, m *Model[T], aliases ...string) error {
+	return Register(&CatalogEntry[T]{
+		Name:    name,
+		Aliases: aliases,
+		Model:   m,
+		Check:   m.Checksum([]byte(CheckInput)),
+		Residue: m.Residue(),
+	})
+}
+
+// Lookup returns the registered entry with the specified
+// name or alias; the name is compared case-insensitively.
+func Lookup(name string) (*RegEntry, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	e, ok := registry.byName[strings.ToUpper(name)]
+	return e, ok
+}
+
+// LookupModel is like [Lookup], but returns the typed Model.
+// It reports false if there is no entry of the specified name,
+// or if the registered Model is not of type *Model[T].
+func LookupModel[T Word](name string) (*Model[T], bool) {
+	e, ok := Lookup(name)
+	if !ok {
+		return nil, false
+	}
+	m, ok := e.model.(*Model[T])
+	return m, ok
+}
+
+// Registered returns the registered entries, sorted by width and name.
+// If widths are specified, only entries of these widths are returned.
+func Registered(widths ...int) []*RegEntry {
+	registry.RLock()
+	var list []*RegEntry
+	for _, e := range registry.entries {
+		if len(widths) != 0 && !containsInt(widths, e.Width) {
+			continue
+		}
+		list = append(list, e)
+	}
+	registry.RUnlock()
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Width != list[j].Width {
+			return list[i].Width < list[j].Width
+		}
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+func containsInt(list []int, v int) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
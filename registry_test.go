@@ -0,0 +1,81 @@
+package crcutil_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	_ "github.com/knieriem/crcutil/catalog"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/crc8"
+	"github.com/knieriem/crcutil/poly16"
+)
+
+func ExampleLookup() {
+	e, ok := crcutil.Lookup("crc-8/maxim")
+	if !ok {
+		return
+	}
+	fmt.Println(e.Name, e.Width)
+	fmt.Printf("%#02x\n", e.Checksum([]byte(crcutil.CheckInput)))
+	// Output:
+	// CRC-8/MAXIM-DOW 8
+	// 0xa1
+}
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"CRC-16/MODBUS", "crc-16/modbus", "Modbus"} {
+		m, ok := crcutil.LookupModel[uint16](name)
+		if !ok {
+			t.Fatalf("%q not found", name)
+		}
+		if m != crc16.Modbus {
+			t.Errorf("%q: unexpected model", name)
+		}
+	}
+	if _, ok := crcutil.LookupModel[uint8]("CRC-16/MODBUS"); ok {
+		t.Error("lookup of typed model with wrong word type succeeded")
+	}
+	if _, ok := crcutil.Lookup("CRC-16/UNKNOWN"); ok {
+		t.Error("lookup of unknown name succeeded")
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	list := crcutil.Registered(8)
+	if len(list) != len(crc8.Catalog) {
+		t.Fatalf("number of 8-bit entries: want %d, got %d", len(crc8.Catalog), len(list))
+	}
+	for _, e := range list {
+		if e.Width != 8 {
+			t.Errorf("%s: unexpected width %d", e.Name, e.Width)
+		}
+		if sum := e.Checksum([]byte(crcutil.CheckInput)); sum != e.Check {
+			t.Errorf("%s: check value mismatch: want %#x, got %#x", e.Name, e.Check, sum)
+		}
+	}
+}
+
+func TestRegisterModel(t *testing.T) {
+	m := &crcutil.Model[uint16]{
+		Poly:          poly16.CCITT,
+		InitialInvert: true,
+		FinalInvert:   true,
+	}
+	err := crcutil.RegisterModel("TEST/GENIBUS", m, "test/genibus-alias")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, ok := crcutil.Lookup("Test/Genibus-Alias")
+	if !ok {
+		t.Fatal("registered model not found")
+	}
+	if e.Check != 0xd64e || e.Residue != 0x1d0f {
+		t.Errorf("unexpected check value or residue: %#04x, %#04x", e.Check, e.Residue)
+	}
+
+	err = crcutil.RegisterModel("CRC-16/X-25", m)
+	if err == nil {
+		t.Error("registration of a duplicate name succeeded")
+	}
+}
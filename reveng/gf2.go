@@ -0,0 +1,90 @@
+package reveng
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// Polynomials over GF(2) of arbitrary degree are represented as big.Int
+// values, with the coefficient of x^0 in the least significant bit.
+
+// polyDivMod returns quotient and remainder of the division a/b.
+func polyDivMod(a, b *big.Int) (q, r *big.Int) {
+	q = new(big.Int)
+	r = new(big.Int).Set(a)
+	db := b.BitLen() - 1
+	t := new(big.Int)
+	for r.BitLen()-1 >= db {
+		n := uint(r.BitLen() - 1 - db)
+		q.SetBit(q, int(n), 1)
+		r.Xor(r, t.Lsh(b, n))
+	}
+	return q, r
+}
+
+// polyGCD returns the greatest common divisor of a and b.
+func polyGCD(a, b *big.Int) *big.Int {
+	for b.Sign() != 0 {
+		_, r := polyDivMod(a, b)
+		a, b = b, r
+	}
+	return a
+}
+
+// system is a system of linear equations over GF(2)
+// with up to 64 unknowns, represented by the bits of a word.
+// It is kept in echelon form: each row's pivot is its lowest bit,
+// which is not set in any row added later.
+type system struct {
+	rows []equation
+}
+
+type equation struct {
+	row uint64
+	rhs bool
+}
+
+// add adds an equation; it reports false, if the equation
+// contradicts the equations added before.
+func (s *system) add(row uint64, rhs bool) bool {
+	for _, e := range s.rows {
+		if row&(e.row&-e.row) != 0 {
+			row ^= e.row
+			rhs = rhs != e.rhs
+		}
+	}
+	if row == 0 {
+		return !rhs
+	}
+	s.rows = append(s.rows, equation{row, rhs})
+	return true
+}
+
+// solve returns a solution of the system of n unknowns;
+// unknowns that are not determined are set to zero.
+// It reports whether the solution is unique.
+func (s *system) solve(n int) (x uint64, unique bool) {
+	// back-substitution in order of descending pivots,
+	// as the other bits of a row are higher than its pivot
+	rows := append([]equation(nil), s.rows...)
+	sortByPivot(rows)
+	for _, e := range rows {
+		v := e.rhs
+		if bits.OnesCount64(e.row&^(e.row&-e.row)&x)%2 == 1 {
+			v = !v
+		}
+		if v {
+			x |= e.row & -e.row
+		}
+	}
+	return x, len(s.rows) == n
+}
+
+// sortByPivot sorts rows by descending pivots.
+func sortByPivot(rows []equation) {
+	for i := 1; i < len(rows); i++ {
+		for j := i; j > 0 && rows[j].row&-rows[j].row > rows[j-1].row&-rows[j-1].row; j-- {
+			rows[j], rows[j-1] = rows[j-1], rows[j]
+		}
+	}
+}
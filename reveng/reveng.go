@@ -0,0 +1,367 @@
+// Package reveng searches for the parameters of CRC algorithms
+// that are consistent with a set of sample messages and their checksums,
+// similar to what the CRC RevEng tool does.
+//
+// The search is based on the linearity of the crc calculation:
+// For two messages of the same length, initial value and final XOR value
+// cancel out, so that the XOR-ed messages, extended by width zero bits,
+// and XOR-ed with the XOR-ed checksums, are a multiple of the polynomial.
+// Candidate polynomials are derived from the greatest common divisor
+// of these multiples; for each candidate, the initial and final
+// XOR values are then determined by solving a system of linear
+// equations over GF(2).
+//
+// Results are compared with the algorithms registered
+// in package crcutil; package reveng imports package catalog,
+// so that all algorithms of the RevEng catalogue are known.
+package reveng
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"sort"
+
+	"github.com/knieriem/crcutil"
+	_ "github.com/knieriem/crcutil/catalog"
+)
+
+// Sample is a message together with its checksum.
+type Sample struct {
+	Message []byte
+	CRC     uint64
+}
+
+// Result is an algorithm consistent with all samples.
+type Result struct {
+	Model *crcutil.AnyModel
+
+	// Entry refers to the registered algorithm
+	// having the same parameters, if any.
+	Entry *crcutil.RegEntry
+
+	// Unique is false, if the initial value and the final XOR value
+	// could not be determined unambiguously, which is the case
+	// if the samples do not differ sufficiently in length,
+	// or if the polynomial is divisible by x+1; in the latter case,
+	// there exist pairs of initial and final XOR values resulting
+	// in the same checksums for all messages.
+	// Then, if a registered algorithm is consistent with the samples,
+	// its parameters are used; otherwise undetermined bits
+	// of the initial value are assumed to be zero.
+	Unique bool
+}
+
+var (
+	ErrSamples = errors.New("reveng: at least two different samples of the same length required")
+)
+
+// IncompleteError is returned by Search, together with the algorithms
+// found, if candidate polynomials could not be enumerated for some widths,
+// because the samples do not narrow down the polynomial sufficiently.
+// Algorithms of these widths may exist, even if none has been found;
+// more, or longer, samples of equal length may help.
+type IncompleteError struct {
+	Widths []int
+}
+
+func (e *IncompleteError) Error() string {
+	return fmt.Sprintf("reveng: search incomplete for widths %v", e.Widths)
+}
+
+// MinWidth and MaxWidth define the range of widths searched on default.
+const (
+	MinWidth = 3
+	MaxWidth = 32
+)
+
+// maxCofactorDegree limits the number of candidates tried
+// if the greatest common divisor found has a degree larger than the width.
+const maxCofactorDegree = 16
+
+// Search returns all algorithms consistent with the samples,
+// sorted by width, polynomial, and reflection parameters.
+// If widths are specified, only algorithms of these widths
+// are searched, otherwise widths MinWidth to MaxWidth.
+// At least two different samples of the same length are required;
+// if there are few samples, or they are short, the result may contain
+// many algorithms, or the search for some widths may be skipped;
+// in the latter case, the algorithms found are returned
+// together with an *IncompleteError.
+func Search(samples []Sample, widths ...int) ([]*Result, error) {
+	if len(widths) == 0 {
+		for w := MinWidth; w <= MaxWidth; w++ {
+			widths = append(widths, w)
+		}
+	}
+	if !hasPairs(samples) {
+		return nil, ErrSamples
+	}
+	var results []*Result
+	var skipped []int
+	for _, w := range widths {
+		if w < 1 || w > MaxWidth {
+			return nil, errors.New("reveng: width out of range")
+		}
+		if !fitWidth(samples, w) {
+			continue
+		}
+		complete := true
+		for _, refIn := range []bool{false, true} {
+			for _, refOut := range []bool{false, true} {
+				s := &searcher{samples: samples, width: w, refIn: refIn, refOut: refOut}
+				r, ok := s.search()
+				results = append(results, r...)
+				complete = complete && ok
+			}
+		}
+		if !complete {
+			skipped = append(skipped, w)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i].Model, results[j].Model
+		if a.Width != b.Width {
+			return a.Width < b.Width
+		}
+		return a.Poly < b.Poly
+	})
+	if len(skipped) != 0 {
+		return results, &IncompleteError{Widths: skipped}
+	}
+	return results, nil
+}
+
+func hasPairs(samples []Sample) bool {
+	for i, a := range samples {
+		for _, b := range samples[i+1:] {
+			if len(a.Message) == len(b.Message) && string(a.Message) != string(b.Message) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func fitWidth(samples []Sample, w int) bool {
+	for _, s := range samples {
+		if s.CRC>>w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+type searcher struct {
+	samples []Sample
+	width   int
+	refIn   bool
+	refOut  bool
+}
+
+// search returns the algorithms consistent with the samples;
+// it reports false, if candidate polynomials could not be enumerated.
+func (s *searcher) search() ([]*Result, bool) {
+	polys, ok := s.polys()
+	var results []*Result
+	for _, poly := range polys {
+		if r := s.solve(poly); r != nil {
+			results = append(results, r)
+		}
+	}
+	return results, ok
+}
+
+// message returns the message as processed by an algorithm in normal form.
+func (s *searcher) message(m []byte) []byte {
+	if !s.refIn {
+		return m
+	}
+	r := make([]byte, len(m))
+	for i, b := range m {
+		r[i] = bits.Reverse8(b)
+	}
+	return r
+}
+
+// crc returns the checksum in normal form.
+func (s *searcher) crc(v uint64) uint64 {
+	if !s.refOut {
+		return v
+	}
+	return reflect(v, s.width)
+}
+
+// polys returns the candidate polynomials, in normal form, without
+// the x^width term, that divide the multiples derived from pairs
+// of samples of equal length. It reports false, if there are
+// too many candidates to be enumerated.
+func (s *searcher) polys() ([]uint64, bool) {
+	var g *big.Int
+	for i, a := range s.samples {
+		for _, b := range s.samples[i+1:] {
+			if len(a.Message) != len(b.Message) {
+				continue
+			}
+			d := new(big.Int).SetBytes(s.message(xor(a.Message, b.Message)))
+			d.Lsh(d, uint(s.width))
+			d.Xor(d, new(big.Int).SetUint64(s.crc(a.CRC^b.CRC)))
+			if g == nil {
+				g = d
+			} else {
+				g = polyGCD(g, d)
+			}
+		}
+	}
+	if g == nil || g.Sign() == 0 {
+		return nil, true
+	}
+	deg := g.BitLen() - 1
+	if deg < s.width {
+		return nil, true
+	}
+	var list []uint64
+	addPoly := func(p *big.Int) {
+		if p.BitLen()-1 != s.width || p.Bit(0) == 0 {
+			return
+		}
+		list = append(list, p.Uint64()&(^uint64(0)>>(64-s.width)))
+	}
+	if d := deg - s.width; d < s.width-1 {
+		// try all cofactors of degree d
+		if d > maxCofactorDegree {
+			return nil, false
+		}
+		for q := uint64(0); q < 1<<d; q++ {
+			cofactor := new(big.Int).SetUint64(1<<d | q)
+			p, r := polyDivMod(g, cofactor)
+			if r.Sign() == 0 {
+				addPoly(p)
+			}
+		}
+	} else {
+		// try all polynomials of degree width having the +1 term
+		if s.width-1 > maxCofactorDegree {
+			return nil, false
+		}
+		for q := uint64(0); q < 1<<(s.width-1); q++ {
+			p := new(big.Int).SetUint64(1<<s.width | q<<1 | 1)
+			if _, r := polyDivMod(g, p); r.Sign() == 0 {
+				addPoly(p)
+			}
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list, true
+}
+
+// solve determines the initial value and the final XOR value
+// for the specified polynomial, and returns nil if the samples
+// are not consistent with it.
+//
+// In normal form, the register after processing a message of length n
+// is Init·x^(8n) mod P ⊕ R, with R being the register resulting from
+// processing the message using an initial value of zero;
+// the checksum equals this value XOR-ed with the final XOR value,
+// which itself is reflected, if RefOut is set.
+// The unknown bits are arranged in a single word, the final XOR value
+// in the lower, the initial value in the upper half.
+func (s *searcher) solve(poly uint64) *Result {
+	w := s.width
+	p := &crcutil.Poly[uint64]{Word: poly, Width: w}
+	var sys system
+	shifts := make(map[int][]uint64)
+	for _, smp := range s.samples {
+		n := len(smp.Message)
+		cols, ok := shifts[n]
+		if !ok {
+			// columns of the multiplication by x^(8n)
+			cols = make([]uint64, w)
+			for j := range cols {
+				cols[j] = update(p, 1<<j, make([]byte, n))
+			}
+			shifts[n] = cols
+		}
+		r := s.crc(smp.CRC) ^ update(p, 0, s.message(smp.Message))
+		for k := 0; k < w; k++ {
+			row := uint64(1) << k
+			for j, c := range cols {
+				row |= (c >> k & 1) << (w + j)
+			}
+			if !sys.add(row, r>>k&1 == 1) {
+				return nil
+			}
+		}
+	}
+	sol, unique := sys.solve(2 * w)
+	mask := ^uint64(0) >> (64 - w)
+	am := &crcutil.AnyModel{
+		Width:  w,
+		Poly:   poly,
+		Init:   sol >> w & mask,
+		RefIn:  s.refIn,
+		RefOut: s.refOut,
+		XorOut: s.crc(sol & mask),
+	}
+	r := &Result{Model: am, Unique: unique}
+	if !unique {
+		for _, e := range crcutil.Registered(w) {
+			if equalParams(e.AnyModel(), am, false) && s.consistent(e.AnyModel()) {
+				em := e.AnyModel()
+				am.Init = em.Init
+				am.XorOut = em.XorOut
+				break
+			}
+		}
+	}
+	for _, e := range crcutil.Registered(w) {
+		if equalParams(e.AnyModel(), am, true) {
+			r.Entry = e
+			am.Name = e.Name
+			break
+		}
+	}
+	if !s.consistent(am) {
+		return nil
+	}
+	return r
+}
+
+func (s *searcher) consistent(m *crcutil.AnyModel) bool {
+	for _, smp := range s.samples {
+		if sum, err := m.Checksum(smp.Message); err != nil || sum != smp.CRC {
+			return false
+		}
+	}
+	return true
+}
+
+// equalParams compares the parameters of two models;
+// init and final XOR value are compared only if full is set.
+func equalParams(a, b *crcutil.AnyModel, full bool) bool {
+	if a.Width != b.Width || a.Poly != b.Poly || a.RefIn != b.RefIn || a.RefOut != b.RefOut {
+		return false
+	}
+	return !full || a.Init == b.Init && a.XorOut == b.XorOut
+}
+
+// update processes p bitwise in normal form.
+func update(p *crcutil.Poly[uint64], crc uint64, msg []byte) uint64 {
+	for _, b := range msg {
+		crc = crcutil.UpdateBitwise(p, crc, uint64(b), 8)
+	}
+	return crc
+}
+
+func xor(a, b []byte) []byte {
+	r := make([]byte, len(a))
+	for i := range r {
+		r[i] = a[i] ^ b[i]
+	}
+	return r
+}
+
+func reflect(v uint64, n int) uint64 {
+	return bits.Reverse64(v) >> (64 - n)
+}
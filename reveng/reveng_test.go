@@ -0,0 +1,121 @@
+package reveng_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/reveng"
+)
+
+func TestRevEng(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, name := range []string{
+		"CRC-5/USB",
+		"CRC-8/SMBUS",
+		"CRC-8/MAXIM-DOW",
+		"CRC-12/UMTS",
+		"CRC-16/MODBUS",
+		"CRC-16/XMODEM",
+		"CRC-24/OPENPGP",
+		"CRC-32/ISO-HDLC",
+		"CRC-32/MPEG-2",
+	} {
+		t.Run(name, func(t *testing.T) {
+			e, ok := crcutil.Lookup(name)
+			if !ok {
+				t.Fatal("model not registered")
+			}
+			var samples []reveng.Sample
+			for _, n := range []int{12, 12, 12, 20} {
+				msg := make([]byte, n)
+				rnd.Read(msg)
+				samples = append(samples, reveng.Sample{Message: msg, CRC: e.Checksum(msg)})
+			}
+			results, err := reveng.Search(samples)
+			var incomplete *reveng.IncompleteError
+			if errors.As(err, &incomplete) {
+				for _, w := range incomplete.Widths {
+					if w == e.Width {
+						t.Fatalf("width %d not searched", w)
+					}
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			found := false
+			for _, r := range results {
+				if r.Entry == e {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("model not found in %d results", len(results))
+			}
+		})
+	}
+}
+
+// TestRevEngCustom verifies that parameters of a model not
+// listed in the catalogue are found, and not flagged as known.
+func TestRevEngCustom(t *testing.T) {
+	m := &crcutil.AnyModel{Width: 13, Poly: 0x1cf5, Init: 0x0123, RefIn: true, RefOut: true, XorOut: 0x1555}
+	var samples []reveng.Sample
+	for _, s := range []string{"Hello, world", "hello, World", "abcdefghijkl", "123456789"} {
+		sum, err := m.Checksum([]byte(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		samples = append(samples, reveng.Sample{Message: []byte(s), CRC: sum})
+	}
+	results, err := reveng.Search(samples, 13)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("%d results, want one", len(results))
+	}
+	r := results[0]
+	if r.Entry != nil {
+		t.Errorf("unexpectedly matching %s", r.Entry.Name)
+	}
+	if got := r.Model.String(); got != m.String() {
+		t.Errorf("want %s, got %s", m, got)
+	}
+}
+
+func TestRevEngSamples(t *testing.T) {
+	_, err := reveng.Search([]reveng.Sample{{Message: []byte("a")}, {Message: []byte("bc")}})
+	if err != reveng.ErrSamples {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// TestRevEngIncomplete verifies that Search reports widths
+// for which candidate polynomials could not be enumerated.
+func TestRevEngIncomplete(t *testing.T) {
+	e, ok := crcutil.Lookup("CRC-24/OPENPGP")
+	if !ok {
+		t.Fatal("model not registered")
+	}
+	// a single pair of short messages results in a multiple
+	// of the polynomial of a degree too large to be factored
+	var samples []reveng.Sample
+	for _, s := range []string{"abc", "zbc"} {
+		samples = append(samples, reveng.Sample{Message: []byte(s), CRC: e.Checksum([]byte(s))})
+	}
+	results, err := reveng.Search(samples, 8, 24)
+	var incomplete *reveng.IncompleteError
+	if !errors.As(err, &incomplete) {
+		t.Fatalf("IncompleteError expected, got %v", err)
+	}
+	if len(incomplete.Widths) != 1 || incomplete.Widths[0] != 24 {
+		t.Errorf("unexpected widths: %v", incomplete.Widths)
+	}
+	for _, r := range results {
+		if r.Model.Width == 24 {
+			t.Errorf("unexpected result: %s", r.Model)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package crcutil
+
+import (
+	"errors"
+	"io"
+)
+
+// RollingInst calculates the crc over a sliding window
+// of the most recently added bytes; the window is advanced
+// in constant time per byte, regardless of its size.
+//
+// When a byte is added to a full window, the contribution of the byte
+// leaving the window is removed using a table derived from the
+// polynomial: entry b contains the register resulting from processing
+// byte b followed by as many zero bytes as the window contains,
+// XOR-ed with the difference of the initial value's contributions
+// to windows of the same size, shifted by one byte.
+type RollingInst[T Word] struct {
+	inst   *Inst[T]
+	out    []T
+	window []byte
+	pos    int
+	full   bool
+	buf    [1]byte
+}
+
+// NewRolling returns a RollingInst calculating the crc
+// over the last n bytes added, using the specified options.
+func (m *Model[T]) NewRolling(n int, opts ...InstOption) *RollingInst[T] {
+	if n < 1 {
+		panic("crcutil: invalid window size")
+	}
+	inst := m.New(opts...)
+	g := newGF2(m.Poly)
+	shift := g.xPow(8, uint64(n))
+	init := m.normalReg(m.initVal())
+	k := g.mul(init, shift) ^ g.mul(init, g.xPow(8, uint64(n+1)))
+
+	poly := m.procPoly()
+	tab := poly.MakeTable()
+	out := make([]T, 256)
+	for i := range out {
+		r := g.mul(m.normalReg(tab[i]), shift)
+		out[i] = m.procReg(r ^ k)
+	}
+	return &RollingInst[T]{
+		inst:   inst,
+		out:    out,
+		window: make([]byte, n),
+	}
+}
+
+// Reset empties the window.
+func (r *RollingInst[T]) Reset() {
+	r.inst.Reset()
+	r.pos = 0
+	r.full = false
+}
+
+// Full reports whether the window is filled; before, the crc
+// is calculated over the bytes added so far.
+func (r *RollingInst[T]) Full() bool {
+	return r.full
+}
+
+// WriteByte adds a byte to the window.
+func (r *RollingInst[T]) WriteByte(b byte) error {
+	inst := r.inst
+	r.buf[0] = b
+	crc := inst.impl.Update(inst.crc, inst.tab, r.buf[:])
+	if r.full {
+		old := r.window[r.pos]
+		if inst.conf.compSwapInputNibbles {
+			old = swapNibbles(old)
+		}
+		crc = inst.adjustCRC(inst.adjustCRC(crc) ^ r.out[old])
+	}
+	inst.crc = crc
+	r.window[r.pos] = b
+	r.pos++
+	if r.pos == len(r.window) {
+		r.pos = 0
+		r.full = true
+	}
+	return nil
+}
+
+// Write implements an io.Writer to add bytes to the window.
+func (r *RollingInst[T]) Write(p []byte) (n int, err error) {
+	for _, b := range p {
+		r.WriteByte(b)
+	}
+	return len(p), nil
+}
+
+// Sum returns the crc checksum of the window.
+func (r *RollingInst[T]) Sum() T {
+	return r.inst.Sum()
+}
+
+// Valid reports whether the window contains a codeword,
+// like [Inst.Valid].
+func (r *RollingInst[T]) Valid() bool {
+	return r.inst.Valid()
+}
+
+// ErrStopScan may be returned by a function passed to Scan
+// to stop scanning without reporting an error.
+var ErrStopScan = errors.New("crcutil: stop scan")
+
+// Scan reads data from rd, adding each byte to the window,
+// and calls found for each filled window the checksum of which equals
+// target, with the offset of the window's first byte relative to the
+// start of the data read. If found returns an error, scanning stops,
+// and the error is returned, unless it is ErrStopScan.
+func (r *RollingInst[T]) Scan(rd io.Reader, target T, found func(off int64) error) error {
+	return r.scan(rd, func() bool { return r.Sum() == target }, found)
+}
+
+// ScanValid is like Scan, but reports windows containing a codeword,
+// i.e. frames of the window's size ending with a valid checksum,
+// which is useful to find frame boundaries in a stream of data.
+func (r *RollingInst[T]) ScanValid(rd io.Reader, found func(off int64) error) error {
+	return r.scan(rd, r.Valid, found)
+}
+
+func (r *RollingInst[T]) scan(rd io.Reader, match func() bool, found func(off int64) error) error {
+	var buf [32 * 1024]byte
+	var off int64
+	for {
+		n, err := rd.Read(buf[:])
+		for _, b := range buf[:n] {
+			r.WriteByte(b)
+			off++
+			if r.full && match() {
+				if err := found(off - int64(len(r.window))); err != nil {
+					if err == ErrStopScan {
+						return nil
+					}
+					return err
+				}
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
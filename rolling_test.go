@@ -0,0 +1,108 @@
+package crcutil_test
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc12"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/crc24"
+	crc3cat "github.com/knieriem/crcutil/crc3"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/crc5"
+	"github.com/knieriem/crcutil/crc64"
+	"github.com/knieriem/crcutil/crc8"
+)
+
+// TestRolling verifies that the checksum of a window equals the
+// checksum calculated over the window's bytes, and the check value,
+// once the window covers the check input.
+func TestRolling(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 200)
+	rnd.Read(data)
+	const pos = 100
+	copy(data[pos:], checkData)
+	data = data[:pos+len(checkData)]
+
+	testRolling(t, crc3cat.Catalog, data)
+	testRolling(t, crc5.Catalog, data)
+	testRolling(t, crc8.Catalog, data)
+	testRolling(t, crc12.Catalog, data)
+	testRolling(t, crc16.Catalog, data)
+	testRolling(t, crc24.Catalog, data)
+	testRolling(t, crc32.Catalog, data)
+	testRolling(t, crc64.Catalog, data)
+}
+
+func testRolling[T crcutil.Word](t *testing.T, cat []*crcutil.CatalogEntry[T], data []byte) {
+	for _, e := range cat {
+		t.Run(e.Name, func(t *testing.T) {
+			m := e.Model
+			for _, n := range []int{1, 4, 33} {
+				r := m.NewRolling(n)
+				for i, b := range data {
+					r.WriteByte(b)
+					start := i + 1 - n
+					if start < 0 {
+						start = 0
+					}
+					if sum, want := r.Sum(), m.Checksum(data[start:i+1]); sum != want {
+						t.Errorf("window %d, offset %d: want %#x, got %#x", n, i, want, sum)
+						break
+					}
+				}
+			}
+			r := m.NewRolling(len(checkData))
+			for _, b := range data {
+				r.WriteByte(b)
+			}
+			if sum := r.Sum(); sum != e.Check {
+				t.Errorf("check input: want %#x, got %#x", e.Check, sum)
+			}
+		})
+	}
+}
+
+func TestRollingScan(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	m := crc16.Modbus
+	payload := []byte("some frame")
+
+	inst := m.New()
+	inst.Update(payload)
+	frame := inst.AppendSum(append([]byte(nil), payload...))
+
+	data := make([]byte, 1000)
+	rnd.Read(data)
+	const pos = 567
+	copy(data[pos:], frame)
+
+	var offsets []int64
+	r := m.NewRolling(len(frame))
+	err := r.ScanValid(bytes.NewReader(data), func(off int64) error {
+		offsets = append(offsets, off)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(offsets) != 1 || offsets[0] != pos {
+		t.Errorf("ScanValid: unexpected offsets: %v", offsets)
+	}
+
+	offsets = nil
+	r = m.NewRolling(len(payload))
+	err = r.Scan(bytes.NewReader(data), m.Checksum(payload), func(off int64) error {
+		offsets = append(offsets, off)
+		return crcutil.ErrStopScan
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(offsets) != 1 || offsets[0] != pos {
+		t.Errorf("Scan: unexpected offsets: %v", offsets)
+	}
+}
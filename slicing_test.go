@@ -0,0 +1,149 @@
+package crcutil_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/crc24"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/crc64"
+)
+
+// TestSlicing verifies check values of slicing-by-n implementations,
+// and compares results for other lengths with byte-wise processing.
+func TestSlicing(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 1000)
+	rnd.Read(data)
+
+	for _, tc := range []struct {
+		name   string
+		slices []int
+	}{
+		{"CRC-16/ARC", []int{4, 8, 16}},
+		{"CRC-16/XMODEM", []int{4, 8, 16}},
+		{"CRC-32/ISO-HDLC", []int{4, 8, 16}},
+		{"CRC-32/BZIP2", []int{4, 8, 16}},
+		{"CRC-64/XZ", []int{8, 16}},
+		{"CRC-64/ECMA-182", []int{8, 16}},
+	} {
+		e, ok := crcutil.Lookup(tc.name)
+		if !ok {
+			t.Fatalf("%s: model not registered", tc.name)
+		}
+		m := e.AnyModel()
+		for _, n := range tc.slices {
+			t.Run(fmt.Sprintf("%s/by%d", tc.name, n), func(t *testing.T) {
+				inst, err := m.New(crcutil.WithSlicing(n))
+				if err != nil {
+					t.Fatal(err)
+				}
+				inst.Update(checkData)
+				if sum := inst.Sum(); sum != e.Check {
+					t.Errorf("check value mismatch: want %#x, got %#x", e.Check, sum)
+				}
+				for _, l := range []int{0, 1, n - 1, n, n + 1, 3*n + 5, len(data)} {
+					want, _ := m.New(crcutil.WithoutStdlib(), crcutil.WithFolding(false))
+					want.Update(data[:l])
+					inst, _ := m.New(crcutil.WithSlicing(n))
+					inst.Update(data[:l])
+					if got := inst.Sum(); got != want.Sum() {
+						t.Errorf("%d bytes: want %#x, got %#x", l, want.Sum(), got)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestSlicingTable verifies that Table returns the table
+// for byte-wise processing, also if slicing-by-n is used.
+func TestSlicingTable(t *testing.T) {
+	want := crc32.BZIP2.MakeTable()
+	for _, n := range []int{0, 1, 4, 8, 16} {
+		tab := crc32.BZIP2.New(crcutil.WithSlicing(n)).Table()
+		if len(tab) != 256 {
+			t.Fatalf("slicing-by-%d: table size %d", n, len(tab))
+		}
+		for i := range tab {
+			if tab[i] != want[i] {
+				t.Fatalf("slicing-by-%d: entry %#02x: want %#x, got %#x", n, i, want[i], tab[i])
+			}
+		}
+	}
+}
+
+// TestSlicingNotSupported verifies that WithSlicing is ignored
+// for models that cannot use a slicing-by-n implementation.
+func TestSlicingNotSupported(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		sum   func() uint64
+		check uint64
+	}{
+		// normal form, width smaller than the word size
+		{"CRC-24/OPENPGP", func() uint64 {
+			inst := crc24.OpenPGP.New(crcutil.WithSlicing(8))
+			inst.Update(checkData)
+			return uint64(inst.Sum())
+		}, 0x21cf02},
+		// n less than the word size
+		{"CRC-32/BZIP2", func() uint64 {
+			inst := crc32.BZIP2.New(crcutil.WithSlicing(2))
+			inst.Update(checkData)
+			return uint64(inst.Sum())
+		}, 0xfc891918},
+		// custom Table
+		{"CRC-16/XMODEM", func() uint64 {
+			m := *crc16.XMODEM
+			m.Table = m.Poly.MakeTable()
+			inst := m.New(crcutil.WithSlicing(8))
+			inst.Update(checkData)
+			return uint64(inst.Sum())
+		}, 0x31c3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if sum := tc.sum(); sum != tc.check {
+				t.Errorf("check value mismatch: want %#x, got %#x", tc.check, sum)
+			}
+		})
+	}
+}
+
+// TestSlicingIgnored verifies that an ignored WithSlicing option
+// does not prevent delegation to the standard library.
+func TestSlicingIgnored(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		stdlib bool
+	}{
+		{"CRC-32/ISO-HDLC/by1", crcutil.UsesStdlib(crc32.ISOHDLC.New(crcutil.WithSlicing(1)))},
+		{"CRC-32/ISO-HDLC/by3", crcutil.UsesStdlib(crc32.ISOHDLC.New(crcutil.WithSlicing(3)))},
+		{"CRC-64/XZ/by4", crcutil.UsesStdlib(crc64.XZ.New(crcutil.WithSlicing(4)))},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.stdlib {
+				t.Error("processing not delegated to the standard library")
+			}
+		})
+	}
+	if crcutil.UsesStdlib(crc32.ISOHDLC.New(crcutil.WithSlicing(8))) {
+		t.Error("slicing-by-8: processing delegated to the standard library")
+	}
+}
+
+func BenchmarkSlicing(b *testing.B) {
+	data := make([]byte, 4096)
+	for _, n := range []int{1, 4, 8, 16} {
+		b.Run(fmt.Sprintf("by%d", n), func(b *testing.B) {
+			inst := crc32.ISOHDLC.New(crcutil.WithSlicing(n), crcutil.WithoutStdlib(), crcutil.WithFolding(false))
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				inst.Update(data)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package crcutil
+
+import (
+	"hash/crc32"
+	"hash/crc64"
+	"sync"
+
+	"github.com/knieriem/crcutil/internal/impl"
+)
+
+// WithoutStdlib ensures that an instance uses the implementations
+// of this package, even if the Model's polynomial is supported by
+// the standard library; see [Model.New].
+// This option is mainly useful for testing and benchmarking.
+func WithoutStdlib() InstOption {
+	return func(c *instConf) {
+		c.noStdlib = true
+	}
+}
+
+// useStdlib reports whether instances of m created with configuration c
+// may delegate processing of bytes to packages hash/crc32 or hash/crc64.
+func useStdlib[T Word](m *Model[T], c *instConf) bool {
+	return m.Table == nil && !c.compSwapInputNibbles && !c.noStdlib &&
+		c.slices == 0 && c.folding != foldingOn
+}
+
+var koopmanTable struct {
+	once sync.Once
+	tab  *crc32.Table
+}
+
+// stdImpl returns an implementation delegating to the standard library,
+// if p, which must be the polynomial used for processing,
+// is the reversed form of one of the polynomials
+// crc32.IEEE, crc32.Castagnoli, crc32.Koopman, crc64.ISO, or crc64.ECMA;
+// otherwise it returns nil.
+// The tables of IEEE and Castagnoli enable hardware acceleration
+// on some architectures.
+func (p *Poly[T]) stdImpl() Impl[T] {
+	if !p.LSBitFirst() || p.Reciprocal {
+		return nil
+	}
+	var i any
+	switch w := any(p.Word).(type) {
+	case uint32:
+		if p.Width != 32 {
+			return nil
+		}
+		switch w {
+		case crc32.IEEE, crc32.Castagnoli:
+			i = impl.Std32{Tab: crc32.MakeTable(w)}
+		case crc32.Koopman:
+			k := &koopmanTable
+			k.once.Do(func() {
+				k.tab = crc32.MakeTable(crc32.Koopman)
+			})
+			i = impl.Std32{Tab: k.tab}
+		default:
+			return nil
+		}
+	case uint64:
+		if p.Width != 64 {
+			return nil
+		}
+		switch w {
+		case crc64.ISO, crc64.ECMA:
+			i = impl.Std64{Tab: crc64.MakeTable(w)}
+		default:
+			return nil
+		}
+	default:
+		return nil
+	}
+	return i.(Impl[T])
+}
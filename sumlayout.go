@@ -0,0 +1,116 @@
+package crcutil
+
+import (
+	"encoding/binary"
+)
+
+// SumLayout describes how a checksum is stored within a frame,
+// as an alternative to the fixed layout used by [Inst.AppendSum],
+// which depends on the implementation.
+//
+// A checksum of a Model of width w occupies Size bytes;
+// if Size is zero, the minimum number of bytes, (w+7)/8, is used.
+// Within these bytes, the checksum's bits are right-aligned,
+// i.e. stored in the least significant bits of the field,
+// unless MSBAligned is set.
+type SumLayout struct {
+	// ByteOrder specifies the order of the bytes of the field.
+	// If nil, big-endian order is used for Models processing
+	// input bytes in normal form, and little-endian order
+	// for Models processing input bytes in reflected form.
+	ByteOrder binary.ByteOrder
+
+	// Reflect mirrors the w bits of the checksum.
+	Reflect bool
+
+	// MSBAligned stores the checksum in the most significant bits
+	// of the field, like a CRC-3 in the top bits of a byte.
+	MSBAligned bool
+
+	// Size is the number of bytes of the field, at most eight.
+	Size int
+}
+
+// size returns the size of the field in bytes.
+func (l *SumLayout) size(width int) int {
+	if l.Size != 0 {
+		return l.Size
+	}
+	return (width + 7) / 8
+}
+
+// littleEndian reports whether the field is stored little-endian.
+func (l *SumLayout) littleEndian(reflected bool) bool {
+	if l.ByteOrder == nil {
+		return reflected
+	}
+	var b [2]byte
+	l.ByteOrder.PutUint16(b[:], 1)
+	return b[0] == 1
+}
+
+// AppendSumAs appends the crc checksum to the provided slice,
+// using the specified layout, and returns the resulting slice.
+// Like in case of AppendSum, the final inversion or XOR-ing
+// will be skipped, if option AppendSumSkipFinalXOR is set.
+// AppendSumAs panics if the checksum does not fit into the field.
+func (inst *Inst[T]) AppendSumAs(in []byte, l SumLayout) []byte {
+	m := inst.model
+	crc := m.reflectOut(inst.adjustCRC(inst.crc))
+	if !inst.conf.appendSumSkipFinalXOR {
+		crc = m.finalXOR(crc)
+	}
+	width := m.Poly.Width
+	if l.Reflect {
+		crc = reverseBits(crc, width)
+	}
+	n := l.size(width)
+	if n > 8 || 8*n < width {
+		panic("crcutil: invalid checksum field size")
+	}
+	v := uint64(crc)
+	if l.MSBAligned {
+		v <<= 8*n - width
+	}
+	if l.littleEndian(m.reflectsIn()) {
+		for i := 0; i < n; i++ {
+			in = append(in, byte(v>>(8*i)))
+		}
+		return in
+	}
+	for i := n - 1; i >= 0; i-- {
+		in = append(in, byte(v>>(8*i)))
+	}
+	return in
+}
+
+// ExtractSum returns the checksum stored at the beginning of p,
+// using the specified layout, as written by [Inst.AppendSumAs].
+// Bits of the field not used by the checksum are ignored.
+// ExtractSum panics if p is shorter than the field.
+func (m *Model[T]) ExtractSum(p []byte, l SumLayout) T {
+	width := m.Poly.Width
+	n := l.size(width)
+	if n > 8 || 8*n < width {
+		panic("crcutil: invalid checksum field size")
+	}
+	p = p[:n]
+	var v uint64
+	if l.littleEndian(m.reflectsIn()) {
+		for i := n - 1; i >= 0; i-- {
+			v = v<<8 | uint64(p[i])
+		}
+	} else {
+		for _, b := range p {
+			v = v<<8 | uint64(b)
+		}
+	}
+	if l.MSBAligned {
+		v >>= 8*n - width
+	}
+	crc := T(v) & m.Poly.mask()
+	if l.Reflect {
+		crc = reverseBits(crc, width)
+	}
+	return crc
+}
@@ -0,0 +1,105 @@
+package crcutil_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc12"
+	"github.com/knieriem/crcutil/crc16"
+	crc3cat "github.com/knieriem/crcutil/crc3"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/crc5"
+	"github.com/knieriem/crcutil/crc64"
+)
+
+func ExampleInst_AppendSumAs() {
+	data := []byte(crcutil.CheckInput)
+
+	// a CRC-16 processed in normal form, sent low byte first
+	inst := crc16.XMODEM.New()
+	inst.Update(data)
+	fmt.Printf("% x\n", inst.AppendSumAs(nil, crcutil.SumLayout{ByteOrder: binary.LittleEndian}))
+
+	// a CRC-3 in the top bits of a byte
+	inst3 := crc3cat.GSM.New()
+	inst3.Update(data)
+	fmt.Printf("%08b\n", inst3.AppendSumAs(nil, crcutil.SumLayout{MSBAligned: true}))
+
+	// a CRC-12 in the lower 12 bits of two bytes, bit-mirrored
+	inst12 := crc12.DECT.New()
+	inst12.Update(data)
+	fmt.Printf("% x\n", inst12.AppendSumAs(nil, crcutil.SumLayout{Reflect: true}))
+
+	// Output:
+	// c3 31
+	// [10000000]
+	// 0d af
+}
+
+type layoutCase struct {
+	layout crcutil.SumLayout
+	want   []byte
+}
+
+// TestSumLayout verifies the fields written by AppendSumAs for the
+// check values of catalogued models, and that ExtractSum returns
+// the check value from these fields.
+func TestSumLayout(t *testing.T) {
+	be, le := binary.BigEndian, binary.LittleEndian
+	testSumLayout(t, "CRC-3/GSM", crc3cat.GSM, 0x4, []layoutCase{
+		{crcutil.SumLayout{}, []byte{0x04}},
+		{crcutil.SumLayout{MSBAligned: true}, []byte{0x80}},
+		{crcutil.SumLayout{Reflect: true}, []byte{0x01}},
+		{crcutil.SumLayout{Reflect: true, MSBAligned: true}, []byte{0x20}},
+	})
+	testSumLayout(t, "CRC-5/USB", crc5.USB, 0x19, []layoutCase{
+		{crcutil.SumLayout{}, []byte{0x19}},
+		{crcutil.SumLayout{MSBAligned: true}, []byte{0xc8}},
+	})
+	testSumLayout(t, "CRC-12/DECT", crc12.DECT, 0xf5b, []layoutCase{
+		{crcutil.SumLayout{}, []byte{0x0f, 0x5b}},
+		{crcutil.SumLayout{ByteOrder: le}, []byte{0x5b, 0x0f}},
+		{crcutil.SumLayout{MSBAligned: true}, []byte{0xf5, 0xb0}},
+		{crcutil.SumLayout{Reflect: true}, []byte{0x0d, 0xaf}},
+	})
+	testSumLayout(t, "CRC-16/XMODEM", crc16.XMODEM, 0x31c3, []layoutCase{
+		{crcutil.SumLayout{}, []byte{0x31, 0xc3}},
+		{crcutil.SumLayout{ByteOrder: le}, []byte{0xc3, 0x31}},
+		{crcutil.SumLayout{Reflect: true}, []byte{0xc3, 0x8c}},
+		{crcutil.SumLayout{Size: 4}, []byte{0x00, 0x00, 0x31, 0xc3}},
+		{crcutil.SumLayout{Size: 4, MSBAligned: true}, []byte{0x31, 0xc3, 0x00, 0x00}},
+		{crcutil.SumLayout{Size: 4, ByteOrder: le}, []byte{0xc3, 0x31, 0x00, 0x00}},
+	})
+	testSumLayout(t, "CRC-16/ARC", crc16.ARC, 0xbb3d, []layoutCase{
+		{crcutil.SumLayout{}, []byte{0x3d, 0xbb}},
+		{crcutil.SumLayout{ByteOrder: be}, []byte{0xbb, 0x3d}},
+	})
+	testSumLayout(t, "CRC-32/ISO-HDLC", crc32.ISOHDLC, 0xcbf43926, []layoutCase{
+		{crcutil.SumLayout{}, []byte{0x26, 0x39, 0xf4, 0xcb}},
+		{crcutil.SumLayout{ByteOrder: be}, []byte{0xcb, 0xf4, 0x39, 0x26}},
+		{crcutil.SumLayout{Size: 8}, []byte{0x26, 0x39, 0xf4, 0xcb, 0, 0, 0, 0}},
+		{crcutil.SumLayout{Size: 8, MSBAligned: true}, []byte{0, 0, 0, 0, 0x26, 0x39, 0xf4, 0xcb}},
+	})
+	testSumLayout(t, "CRC-64/XZ", crc64.XZ, 0x995dc9bbdf1939fa, []layoutCase{
+		{crcutil.SumLayout{}, []byte{0xfa, 0x39, 0x19, 0xdf, 0xbb, 0xc9, 0x5d, 0x99}},
+		{crcutil.SumLayout{ByteOrder: be}, []byte{0x99, 0x5d, 0xc9, 0xbb, 0xdf, 0x19, 0x39, 0xfa}},
+	})
+}
+
+func testSumLayout[T crcutil.Word](t *testing.T, name string, m *crcutil.Model[T], check T, cases []layoutCase) {
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s/%+v", name, tc.layout), func(t *testing.T) {
+			inst := m.New()
+			inst.Update(checkData)
+			if b := inst.AppendSumAs(nil, tc.layout); !bytes.Equal(b, tc.want) {
+				t.Errorf("AppendSumAs: want % x, got % x", tc.want, b)
+			}
+			if sum := m.ExtractSum(tc.want, tc.layout); sum != check {
+				t.Errorf("ExtractSum: want %#x, got %#x", check, sum)
+			}
+		})
+	}
+}
@@ -1,8 +1,7 @@
 package crcutil
 
 import (
-	"fmt"
-	"sync"
+	"unsafe"
 
 	"github.com/knieriem/crcutil/internal/impl"
 )
@@ -17,12 +16,16 @@ func (poly *Poly[T]) MakeTable(opts ...TableOption) []T {
 		o(&conf)
 	}
 
-	k := tableCacheKey(poly, &conf)
-	tableCacheMu.RLock()
-	t := tableCache[k]
-	tableCacheMu.RUnlock()
-	if t != nil {
-		return t.([]T)
+	cache := tableCacheFor[T]()
+	k := makeTableCacheKey(poly, &conf)
+	if t := cache.get(k); t != nil {
+		return t
+	}
+
+	if conf.inverse {
+		tab := poly.inverseTable()
+		cache.add(k, tab)
+		return tab
 	}
 
 	N := 1 << conf.dataWidth
@@ -43,12 +46,28 @@ func (poly *Poly[T]) MakeTable(opts ...TableOption) []T {
 		tab[ti] = crc
 	}
 
-	tableCacheMu.Lock()
-	defer tableCacheMu.Unlock()
-	tableCache[k] = tab
+	if conf.slices > 1 {
+		tab = poly.extendTable(tab, conf.slices)
+	}
+
+	cache.add(k, tab)
 	return tab
 }
 
+// extendTable returns a table consisting of n tables of 256 entries,
+// with the k-th table containing the crc values of each byte value
+// followed by k zero bytes.
+func (poly *Poly[T]) extendTable(tab []T, n int) []T {
+	impl := poly.Impl()
+	zero := []byte{0}
+	ext := make([]T, 256*n)
+	copy(ext, tab)
+	for k := 256; k < len(ext); k++ {
+		ext[k] = impl.Update(ext[k-256], tab, zero)
+	}
+	return ext
+}
+
 func swapNibbles[T Word](x T) T {
 	return (x&0xf)<<4 | (x&0xf0)>>4
 }
@@ -56,9 +75,11 @@ func swapNibbles[T Word](x T) T {
 type TableOption func(*tableConf)
 
 type tableConf struct {
-	initial     uint32
+	initial     uint64
 	dataWidth   int
 	reverseBits bool
+	slices      int
+	inverse     bool
 
 	swapInputNibbles bool
 }
@@ -78,7 +99,7 @@ func WithDataWidth(n int) TableOption {
 // In cases where a table later is used manually,
 // like when a CRC is calulated over some bits only,
 // this saves one XOR operation.
-func WithInitialValue(initial uint32) TableOption {
+func WithInitialValue(initial uint64) TableOption {
 	return func(c *tableConf) {
 		c.initial = initial
 	}
@@ -91,37 +112,68 @@ func WithReversedBits() TableOption {
 	}
 }
 
-// withSwappedInputNibbles generates a table so that nibbles
-// of input bytes will be processed in reversed order.
-// This option is useful for byte-wise processed 4-bit CRCs.
-func withSwappedInputNibbles() TableOption {
+// WithSlices creates an extended table for slicing-by-n implementations,
+// consisting of n tables of 256 entries each, where the k-th table
+// contains the crc values of each byte value followed by k zero bytes.
+// The first table equals the one created without this option.
+// WithSlices implies a data width of 8 bits, and must not be combined with
+// other options, except WithInitialValue.
+func WithSlices(n int) TableOption {
 	return func(c *tableConf) {
-		c.swapInputNibbles = true
+		c.slices = n
 		c.dataWidth = 8
 	}
 }
 
-var tableCacheMu sync.RWMutex
-var tableCache = map[string]any{}
+// MakeInverseTable creates a table of 256 entries for reversing
+// the byte-wise crc calculation, as used by [Inst.Unupdate].
+// In normal form, entry i contains i·x⁻⁸ mod P;
+// in reversed form, the index and the entries are reflected, so that
+// entry i contains the value for the byte found in the most significant
+// eight bits of the register. If the polynomial is narrower
+// than eight bits, the index consists of the whole register.
+// The polynomial must have the +1 term.
+func (poly *Poly[T]) MakeInverseTable() []T {
+	return poly.MakeTable(func(c *tableConf) {
+		c.inverse = true
+	})
+}
 
-func tableCacheKey[T Word](p *Poly[T], c *tableConf) string {
-	rep := ""
-	if p.Reversed {
-		rep += ".r"
-	}
-	if p.Reciprocal {
-		rep += ".R"
+func (poly *Poly[T]) inverseTable() []T {
+	g := newGF2(poly)
+	w := poly.Width
+	n := 8
+	if w < 8 {
+		n = w
 	}
-	tabMod := ""
-	if c.reverseBits {
-		tabMod += ".r"
+	tab := make([]T, 256)
+	for i := range tab {
+		if i>>n != 0 {
+			break
+		}
+		v := uint64(i)
+		if poly.LSBitFirst() {
+			v = uint64(reverseBits(T(i), n))
+		}
+		for k := 0; k < 8; k++ {
+			v = g.divX(v)
+		}
+		if poly.LSBitFirst() {
+			v = uint64(reverseBits(T(v), w))
+		}
+		tab[i] = T(v)
 	}
-	if c.swapInputNibbles {
-		tabMod += ".sn"
+	return tab
+}
+
+// withSwappedInputNibbles generates a table so that nibbles
+// of input bytes will be processed in reversed order.
+// This option is useful for byte-wise processed 4-bit CRCs.
+func withSwappedInputNibbles() TableOption {
+	return func(c *tableConf) {
+		c.swapInputNibbles = true
+		c.dataWidth = 8
 	}
-	return fmt.Sprintf("%x%s-%x.%d%s",
-		p.Word, rep,
-		c.initial, c.dataWidth, tabMod)
 }
 
 type Impl[T Word] interface {
@@ -129,22 +181,100 @@ type Impl[T Word] interface {
 	Append(b []byte, crc T) []byte
 }
 
+// Impl returns an implementation that processes data byte-wise
+// using a table created by MakeTable.
+// In normal form, polynomials narrower than T are supported
+// by implementations that regard the actual width.
 func (p *Poly[T]) Impl() Impl[T] {
 	var x T
 
+	if !p.LSBitFirst() && p.Width < 8*int(unsafe.Sizeof(x)) {
+		return p.narrowImpl()
+	}
+
+	var i any
 	switch any(x).(type) {
 	case uint8:
-		return impl.Impl8[T]{}
+		i = impl.Impl8[uint8]{}
 	case uint16:
 		if p.LSBitFirst() {
-			return impl.Impl16LSBitFirst[T]{}
+			i = impl.Impl16LSBitFirst[uint16]{}
+		} else {
+			i = impl.Impl16[uint16]{}
 		}
-		return impl.Impl16[T]{}
 	case uint32:
 		if p.LSBitFirst() {
-			return impl.Impl32LSBitFirst[T]{}
+			i = impl.Impl32LSBitFirst[uint32]{}
+		} else {
+			i = impl.Impl32[uint32]{}
+		}
+	case uint64:
+		if p.LSBitFirst() {
+			i = impl.Impl64LSBitFirst[uint64]{}
+		} else {
+			i = impl.Impl64[uint64]{}
+		}
+	default:
+		panic("type not supported")
+	}
+	return i.(Impl[T])
+}
+
+// slicingImpl returns a slicing-by-n implementation that
+// processes data using a table created with WithSlices(n),
+// or nil, if there is no such implementation for the polynomial.
+func (p *Poly[T]) slicingImpl(n int) Impl[T] {
+	var x T
+	size := int(unsafe.Sizeof(x))
+	if n != 4 && n != 8 && n != 16 || n < size {
+		return nil
+	}
+	if !p.LSBitFirst() && p.Width < 8*size {
+		return nil
+	}
+
+	var i any
+	switch any(x).(type) {
+	case uint16:
+		if p.LSBitFirst() {
+			i = impl.Slicing16LSBitFirst[uint16]{N: n}
+		} else {
+			i = impl.Slicing16[uint16]{N: n}
 		}
-		return impl.Impl32[T]{}
+	case uint32:
+		if p.LSBitFirst() {
+			i = impl.Slicing32LSBitFirst[uint32]{N: n}
+		} else {
+			i = impl.Slicing32[uint32]{N: n}
+		}
+	case uint64:
+		if p.LSBitFirst() {
+			i = impl.Slicing64LSBitFirst[uint64]{N: n}
+		} else {
+			i = impl.Slicing64[uint64]{N: n}
+		}
+	default:
+		return nil
+	}
+	return i.(Impl[T])
+}
+
+func (p *Poly[T]) narrowImpl() Impl[T] {
+	if p.Width < 8 {
+		return impl.ImplNarrow8[T]{Width: p.Width}
+	}
+
+	var x T
+	var i any
+	switch any(x).(type) {
+	case uint16:
+		i = impl.ImplNarrow[uint16]{Width: p.Width}
+	case uint32:
+		i = impl.ImplNarrow[uint32]{Width: p.Width}
+	case uint64:
+		i = impl.ImplNarrow[uint64]{Width: p.Width}
+	default:
+		panic("type not supported")
 	}
-	panic("type not supported")
+	return i.(Impl[T])
 }
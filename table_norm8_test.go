@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/knieriem/crcutil"
 	"github.com/knieriem/crcutil/poly8"
 )
 
@@ -31,6 +32,18 @@ func TestDowCRCNorm(t *testing.T) {
 	}
 }
 
+// TestDowCRCNormVerify verifies the idFrameParts using Model.Verify.
+func TestDowCRCNormVerify(t *testing.T) {
+	m := &crcutil.Model[uint8]{Poly: poly8.DOW}
+	for i, part := range idFrameParts {
+		t.Run(fmt.Sprintf("verify idFrame part %d", i), func(t *testing.T) {
+			if !m.Verify(part) {
+				t.Errorf("frame % x not accepted", part)
+			}
+		})
+	}
+}
+
 var idFrameParts = [][]byte{
 	// id1
 	{0xa1, 0xcd},
@@ -10,16 +10,12 @@ var ibmcrc16 = (&Poly[uint16]{Word: 0x8005, Width: 16}).ReversedForm()
 // each time calculating the same example checksum.
 // Between sub tests, the table cache state is verified.
 func TestMakeTable(t *testing.T) {
-	cacheKey := tableCacheKey(ibmcrc16, &tableConf{
+	cacheKey := makeTableCacheKey(ibmcrc16, &tableConf{
 		dataWidth: 8,
 	})
-	t.Run("verify cache key", func(t *testing.T) {
-		if cacheKey != "a001.r-0.8" {
-			t.Fatalf("cache key mismatch: %q", cacheKey)
-		}
-	})
+	cache := tableCacheFor[uint16]()
 	t.Run("verify nil cache state", func(t *testing.T) {
-		if tableCache[cacheKey] != nil {
+		if cache.get(cacheKey) != nil {
 			t.Fatal("tableCache entry not nil")
 		}
 	})
@@ -29,11 +25,10 @@ func TestMakeTable(t *testing.T) {
 
 	// now there must be an entry for ibmcrc16 in the cache
 	t.Run("verify initialized cache state", func(t *testing.T) {
-		u, ok := tableCache[cacheKey].([]uint16)
-		if !ok {
+		tab = cache.get(cacheKey)
+		if tab == nil {
 			t.Fatal("tableCache entry missing")
 		}
-		tab = u
 	})
 
 	t.Run("with existing cache entry", checkMakeTable)
@@ -43,6 +38,67 @@ func TestMakeTable(t *testing.T) {
 	}
 }
 
+// TestTableCacheKey verifies that polynomials with the same word,
+// but different widths or word types, result in different tables.
+func TestTableCacheKey(t *testing.T) {
+	p3 := &Poly[uint8]{Word: 3, Width: 3}
+	p4 := &Poly[uint8]{Word: 3, Width: 4}
+	if tab3, tab4 := p3.MakeTable(), p4.MakeTable(); &tab3[0] == &tab4[0] {
+		t.Error("3-bit and 4-bit polynomials share the same table")
+	}
+
+	p8 := &Poly[uint8]{Word: 0x07, Width: 8}
+	p16 := &Poly[uint16]{Word: 0x07, Width: 8}
+	tab8 := p8.MakeTable()
+	tab16 := p16.MakeTable()
+	for i := range tab8 {
+		if uint16(tab8[i]) != tab16[i] {
+			t.Fatalf("table entries differ at index %d", i)
+		}
+	}
+}
+
+func TestTableCacheLRU(t *testing.T) {
+	prev := SetTableCacheSize(2)
+	defer SetTableCacheSize(prev)
+	defer ClearTableCache()
+	ClearTableCache()
+
+	polys := []*Poly[uint32]{
+		{Word: 0x04c11db7, Width: 32},
+		{Word: 0x1edc6f41, Width: 32},
+		{Word: 0x741b8cd7, Width: 32},
+	}
+	polys[0].MakeTable()
+	polys[1].MakeTable()
+	polys[0].MakeTable() // polys[1] is now the least recently used one
+	polys[2].MakeTable()
+
+	s := TableCacheState()
+	if s.Tables != 2 || s.Bytes != 2*256*4 {
+		t.Errorf("unexpected cache state: %+v", s)
+	}
+	if s.Hits != 1 || s.Misses != 3 {
+		t.Errorf("unexpected hit/miss counts: %+v", s)
+	}
+	cache := tableCacheFor[uint32]()
+	for i, cached := range []bool{true, false, true} {
+		k := makeTableCacheKey(polys[i], &tableConf{dataWidth: 8})
+		if (cache.get(k) != nil) != cached {
+			t.Errorf("poly %d: unexpected cache state, expected cached: %v", i, cached)
+		}
+	}
+
+	SetTableCacheSize(0)
+	if s := TableCacheState(); s.Tables != 0 {
+		t.Errorf("cache not empty after disabling it: %+v", s)
+	}
+	polys[0].MakeTable()
+	if s := TableCacheState(); s.Tables != 0 {
+		t.Errorf("table cached while caching is disabled: %+v", s)
+	}
+}
+
 var modExFrame = []byte{2, 7}
 
 // CheckMakeTable creates a table for the CRC-16-IBM polynomial,
@@ -63,3 +119,17 @@ func update16(crc uint16, tab []uint16, p []byte) uint16 {
 	}
 	return crc
 }
+
+// TestInitialValue64 verifies that initial values exceeding
+// 32 bits are applied to the table entries of 64-bit words.
+func TestInitialValue64(t *testing.T) {
+	p := &Poly[uint64]{Word: 0x0004820009, Width: 40}
+	const initial = 0xff_0000_0001
+	tab := p.MakeTable(WithInitialValue(initial))
+	for _, i := range []int{0, 1, 0x80, 0xff} {
+		want := UpdateBitwise(p, uint64(initial), uint64(i), 8)
+		if tab[i] != want {
+			t.Errorf("entry %#02x: want %#x, got %#x", i, want, tab[i])
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package crcutil
+
+import (
+	"container/list"
+	"sync"
+	"unsafe"
+)
+
+// DefaultTableCacheSize is the default maximum number of tables
+// per word type kept in the table cache.
+const DefaultTableCacheSize = 64
+
+// Tables created by MakeTable are kept in a cache,
+// so that creating multiple instances of the same Model,
+// or of Models sharing a polynomial, does not result in
+// repeated calculations of the same table.
+// There is a separate cache for each word type, holding
+// a limited number of tables; if the limit is reached,
+// the least recently used table is dropped.
+//
+// Tables returned from the cache are shared, and must not be modified.
+var tableCaches struct {
+	sync.Mutex
+	size int
+
+	c8  tableCache[uint8]
+	c16 tableCache[uint16]
+	c32 tableCache[uint32]
+	c64 tableCache[uint64]
+}
+
+func init() {
+	tableCaches.size = DefaultTableCacheSize
+}
+
+// tableCacheKey identifies a table by the polynomial,
+// including its width and representation, and the table options.
+type tableCacheKey struct {
+	word       uint64
+	width      int
+	reversed   bool
+	reciprocal bool
+	conf       tableConf
+}
+
+func makeTableCacheKey[T Word](p *Poly[T], c *tableConf) tableCacheKey {
+	return tableCacheKey{
+		word:       uint64(p.Word),
+		width:      p.Width,
+		reversed:   p.Reversed,
+		reciprocal: p.Reciprocal,
+		conf:       *c,
+	}
+}
+
+type tableCache[T Word] struct {
+	lru     list.List // of *tableCacheEntry[T], most recently used first
+	entries map[tableCacheKey]*list.Element
+
+	hits, misses uint64
+}
+
+type tableCacheEntry[T Word] struct {
+	key tableCacheKey
+	tab []T
+}
+
+func tableCacheFor[T Word]() *tableCache[T] {
+	var c any
+	switch any(T(0)).(type) {
+	case uint8:
+		c = &tableCaches.c8
+	case uint16:
+		c = &tableCaches.c16
+	case uint32:
+		c = &tableCaches.c32
+	case uint64:
+		c = &tableCaches.c64
+	}
+	return c.(*tableCache[T])
+}
+
+func (c *tableCache[T]) get(k tableCacheKey) []T {
+	tableCaches.Lock()
+	defer tableCaches.Unlock()
+	if e, ok := c.entries[k]; ok {
+		c.hits++
+		c.lru.MoveToFront(e)
+		return e.Value.(*tableCacheEntry[T]).tab
+	}
+	c.misses++
+	return nil
+}
+
+func (c *tableCache[T]) add(k tableCacheKey, tab []T) {
+	tableCaches.Lock()
+	defer tableCaches.Unlock()
+	if tableCaches.size == 0 {
+		return
+	}
+	if c.entries == nil {
+		c.entries = make(map[tableCacheKey]*list.Element)
+	}
+	if e, ok := c.entries[k]; ok {
+		c.lru.MoveToFront(e)
+		return
+	}
+	c.entries[k] = c.lru.PushFront(&tableCacheEntry[T]{key: k, tab: tab})
+	c.trim(tableCaches.size)
+}
+
+// trim drops least recently used entries until
+// the cache contains at most n tables.
+func (c *tableCache[T]) trim(n int) {
+	for c.lru.Len() > n {
+		e := c.lru.Back()
+		c.lru.Remove(e)
+		delete(c.entries, e.Value.(*tableCacheEntry[T]).key)
+	}
+}
+
+func (c *tableCache[T]) addStats(s *TableCacheStats) {
+	s.Tables += c.lru.Len()
+	for e := c.lru.Front(); e != nil; e = e.Next() {
+		tab := e.Value.(*tableCacheEntry[T]).tab
+		s.Bytes += len(tab) * int(unsafe.Sizeof(tab[0]))
+	}
+	s.Hits += c.hits
+	s.Misses += c.misses
+}
+
+// SetTableCacheSize sets the maximum number of tables per word type
+// that are kept in the table cache, and returns the previous value.
+// A size of zero disables caching.
+// If the cache contains more tables than allowed by the new size,
+// the least recently used ones are dropped.
+func SetTableCacheSize(n int) int {
+	if n < 0 {
+		n = 0
+	}
+	tableCaches.Lock()
+	defer tableCaches.Unlock()
+	prev := tableCaches.size
+	tableCaches.size = n
+	tableCaches.c8.trim(n)
+	tableCaches.c16.trim(n)
+	tableCaches.c32.trim(n)
+	tableCaches.c64.trim(n)
+	return prev
+}
+
+// ClearTableCache drops all tables from the table cache,
+// and resets its statistics.
+func ClearTableCache() {
+	tableCaches.Lock()
+	defer tableCaches.Unlock()
+	tableCaches.c8 = tableCache[uint8]{}
+	tableCaches.c16 = tableCache[uint16]{}
+	tableCaches.c32 = tableCache[uint32]{}
+	tableCaches.c64 = tableCache[uint64]{}
+}
+
+// TableCacheStats describes the state of the table cache.
+type TableCacheStats struct {
+	Size   int // maximum number of tables per word type
+	Tables int // number of cached tables
+	Bytes  int // memory used by cached tables
+
+	// Hits and Misses count the lookups in the cache
+	// that found, or did not find, a table.
+	Hits   uint64
+	Misses uint64
+}
+
+// TableCacheState returns the current state of the table cache.
+func TableCacheState() TableCacheStats {
+	tableCaches.Lock()
+	defer tableCaches.Unlock()
+	s := TableCacheStats{Size: tableCaches.size}
+	tableCaches.c8.addStats(&s)
+	tableCaches.c16.addStats(&s)
+	tableCaches.c32.addStats(&s)
+	tableCaches.c64.addStats(&s)
+	return s
+}
+
+// Prewarm creates the tables used by instances of the Model
+// created with the specified options, so that they are available
+// from the table cache when the instances are actually created,
+// e.g. to avoid latencies while serving requests in long-running services.
+func (m *Model[T]) Prewarm(opts ...InstOption) {
+	m.New(opts...)
+}
@@ -0,0 +1,39 @@
+package crcutil
+
+// Unupdate reverses the effect of adding the bytes in p to the crc,
+// which must be the bytes most recently added, like when trailing
+// padding shall be removed from data that has already been processed.
+// Bytes are processed in reverse order, using the table returned by
+// MakeInverseTable; the polynomial must have the +1 term.
+// Unupdate panics if the Model specifies a custom Table,
+// since the inverse table is derived from the polynomial.
+func (inst *Inst[T]) Unupdate(p []byte) {
+	if inst.model.Table != nil {
+		panic("crcutil: Unupdate not supported with a custom Table")
+	}
+	poly := inst.model.procPoly()
+	tab := poly.MakeTable()
+	inv := poly.MakeInverseTable()
+	w := poly.Width
+	mask := uint64(poly.mask())
+
+	crc := uint64(inst.adjustCRC(inst.crc))
+	for i := len(p) - 1; i >= 0; i-- {
+		// remove the contribution of the byte,
+		// then multiply by x⁻⁸
+		v := p[i]
+		if inst.conf.compSwapInputNibbles {
+			v = swapNibbles(v)
+		}
+		c := crc ^ uint64(tab[v])
+		switch {
+		case w < 8:
+			crc = uint64(inv[c])
+		case poly.LSBitFirst():
+			crc = c<<8&mask ^ uint64(inv[byte(c>>(w-8))])
+		default:
+			crc = c>>8 ^ uint64(inv[byte(c)])
+		}
+	}
+	inst.crc = inst.adjustCRC(T(crc))
+}
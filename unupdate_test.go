@@ -0,0 +1,91 @@
+package crcutil_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc12"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/crc24"
+	crc3cat "github.com/knieriem/crcutil/crc3"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/crc4"
+	"github.com/knieriem/crcutil/crc5"
+	"github.com/knieriem/crcutil/crc64"
+	"github.com/knieriem/crcutil/crc8"
+)
+
+// TestUnupdate verifies that removing trailing bytes using Unupdate
+// results in the check value, if the check input remains, and in
+// the checksum of the remaining data otherwise.
+func TestUnupdate(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	trailer := make([]byte, 100)
+	rnd.Read(trailer)
+
+	testUnupdate(t, crc3cat.Catalog, trailer)
+	testUnupdate(t, crc5.Catalog, trailer)
+	testUnupdate(t, crc8.Catalog, trailer)
+	testUnupdate(t, crc12.Catalog, trailer)
+	testUnupdate(t, crc16.Catalog, trailer)
+	testUnupdate(t, crc24.Catalog, trailer)
+	testUnupdate(t, crc32.Catalog, trailer)
+	testUnupdate(t, crc64.Catalog, trailer)
+}
+
+func testUnupdate[T crcutil.Word](t *testing.T, cat []*crcutil.CatalogEntry[T], trailer []byte) {
+	data := append(append([]byte(nil), checkData...), trailer...)
+	for _, e := range cat {
+		t.Run(e.Name, func(t *testing.T) {
+			m := e.Model
+			for _, n := range []int{0, 1, 7, 60, len(trailer)} {
+				inst := m.New()
+				inst.Update(data[:len(checkData)+n])
+				inst.Unupdate(trailer[:n])
+				if sum := inst.Sum(); sum != e.Check {
+					t.Errorf("%d bytes removed: want %#x, got %#x", n, e.Check, sum)
+				}
+			}
+			for _, n := range []int{4, len(checkData)} {
+				keep := len(checkData) - n
+				inst := m.New()
+				inst.Update(checkData)
+				inst.Unupdate(checkData[keep:])
+				if sum, want := inst.Sum(), m.Checksum(checkData[:keep]); sum != want {
+					t.Errorf("%d bytes of check input removed: want %#x, got %#x", n, want, sum)
+				}
+			}
+		})
+	}
+}
+
+func TestUnupdateSwappedNibbles(t *testing.T) {
+	m := crc4.G704
+	data := []byte{0x12, 0x34, 0x56}
+	want := m.New(crcutil.WithSwappedInputNibbles())
+	want.Update(data[:1])
+	inst := m.New(crcutil.WithSwappedInputNibbles())
+	inst.Update(data)
+	inst.Unupdate(data[1:])
+	if inst.Sum() != want.Sum() {
+		t.Errorf("want %#x, got %#x", want.Sum(), inst.Sum())
+	}
+}
+
+// TestUnupdateCustomTable verifies that Unupdate rejects models
+// specifying a custom Table, which might not match the polynomial.
+func TestUnupdateCustomTable(t *testing.T) {
+	m := &crcutil.Model[uint8]{
+		Poly:  crc8.SMBus.Poly,
+		Table: crc8.SMBus.Poly.MakeTable(crcutil.WithInitialValue(0x55)),
+	}
+	inst := m.New()
+	inst.Update(checkData)
+	defer func() {
+		if recover() == nil {
+			t.Error("Unupdate did not panic")
+		}
+	}()
+	inst.Unupdate(checkData[4:])
+}
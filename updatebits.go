@@ -0,0 +1,72 @@
+package crcutil
+
+import (
+	"math/bits"
+)
+
+// BitOrder specifies the order in which the bits of a byte are processed.
+type BitOrder int
+
+const (
+	// MSBitFirst processes the most significant bit of a byte first.
+	MSBitFirst BitOrder = iota
+
+	// LSBitFirst processes the least significant bit of a byte first.
+	LSBitFirst
+)
+
+// UpdateBits adds nbits bits of p, starting at bit offset bitOffset,
+// to the crc. Bits are counted, and processed, in the specified order
+// within each byte: bit offset 0 refers to the most significant bit
+// of p[0] in case of MSBitFirst, and to the least significant bit
+// in case of LSBitFirst; bit offset 8 refers to the corresponding bit of p[1].
+// Bits at the edges of the range are processed bitwise, whole bytes
+// in between using the instance's table; if the bit order does not
+// match the order in which the Model processes input bytes,
+// the bytes are reflected before.
+// UpdateBits panics if the range exceeds p.
+func (inst *Inst[T]) UpdateBits(p []byte, bitOffset, nbits int, order BitOrder) {
+	if bitOffset < 0 || nbits < 0 || bitOffset+nbits > 8*len(p) {
+		panic("crcutil: bit range out of bounds")
+	}
+	m := inst.model
+	poly := m.procPoly()
+	crc := inst.adjustCRC(inst.crc)
+
+	i, end := bitOffset, bitOffset+nbits
+	for ; i < end && (i%8 != 0 || inst.conf.compSwapInputNibbles); i++ {
+		crc = UpdateBitwise(poly, crc, T(bitAt(p, i, order)), 1)
+	}
+	if n := (end - i) / 8; n > 0 {
+		crc = inst.adjustCRC(crc)
+		b := p[i/8 : i/8+n]
+		if (order == LSBitFirst) == poly.LSBitFirst() {
+			crc = inst.impl.Update(crc, inst.tab, b)
+		} else {
+			var buf [64]byte
+			for len(b) != 0 {
+				k := copy(buf[:], b)
+				for j, v := range buf[:k] {
+					buf[j] = bits.Reverse8(v)
+				}
+				crc = inst.impl.Update(crc, inst.tab, buf[:k])
+				b = b[k:]
+			}
+		}
+		crc = inst.adjustCRC(crc)
+		i += 8 * n
+	}
+	for ; i < end; i++ {
+		crc = UpdateBitwise(poly, crc, T(bitAt(p, i, order)), 1)
+	}
+	inst.crc = inst.adjustCRC(crc)
+}
+
+// bitAt returns the bit at offset i of p, counted in the specified order.
+func bitAt(p []byte, i int, order BitOrder) byte {
+	shift := i % 8
+	if order == MSBitFirst {
+		shift = 7 - shift
+	}
+	return p[i/8] >> shift & 1
+}
@@ -0,0 +1,116 @@
+package crcutil_test
+
+import (
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc12"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/crc5"
+	"github.com/knieriem/crcutil/crc64"
+	"github.com/knieriem/crcutil/crc8"
+	"github.com/knieriem/crcutil/poly3"
+)
+
+// This example calculates the 3-bit CRC of the frame described
+// in the example of Poly.MakeTable, over the five bits of
+// the device address, and the eight bits of the second byte,
+// without creating tables manually.
+func ExampleInst_UpdateBits() {
+	m := &crcutil.Model[uint8]{
+		Poly:    poly3.GSM,
+		Initial: 5,
+		RefIn:   true,
+	}
+	frame := []byte{10, 0b0111_0101}
+
+	inst := m.New()
+	inst.UpdateBits(frame, 0, 5, crcutil.LSBitFirst)
+	inst.UpdateBits(frame, 8, 8, crcutil.LSBitFirst)
+	fmt.Println(inst.Sum())
+	// Output: 4
+}
+
+// TestUpdateBits verifies check values processed bytewise and bit by bit,
+// and compares results for arbitrary bit ranges against a bitwise
+// calculation in normal form.
+func TestUpdateBits(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 100)
+	rnd.Read(data)
+
+	testUpdateBits(t, crc5.Catalog, rnd, data)
+	testUpdateBits(t, crc8.Catalog, rnd, data)
+	testUpdateBits(t, crc12.Catalog, rnd, data)
+	testUpdateBits(t, crc16.Catalog, rnd, data)
+	testUpdateBits(t, crc32.Catalog, rnd, data)
+	testUpdateBits(t, crc64.Catalog, rnd, data)
+}
+
+func testUpdateBits[T crcutil.Word](t *testing.T, cat []*crcutil.CatalogEntry[T], rnd *rand.Rand, data []byte) {
+	reflected := make([]byte, len(checkData))
+	for i, b := range checkData {
+		reflected[i] = bits.Reverse8(b)
+	}
+	for _, e := range cat {
+		t.Run(e.Name, func(t *testing.T) {
+			m := e.Model
+			am := crcutil.NewAnyModel(m)
+			native, other := crcutil.MSBitFirst, crcutil.LSBitFirst
+			if am.RefIn {
+				native, other = other, native
+			}
+			n := 8 * len(checkData)
+			if sum := updateBits(m, checkData, native, 0, n); sum != e.Check {
+				t.Errorf("whole bytes: want %#x, got %#x", e.Check, sum)
+			}
+			if sum := updateBits(m, reflected, other, 0, n); sum != e.Check {
+				t.Errorf("reflected bytes: want %#x, got %#x", e.Check, sum)
+			}
+			var ranges []int
+			for j := 0; j < n; j++ {
+				ranges = append(ranges, j, 1)
+			}
+			if sum := updateBits(m, checkData, native, ranges...); sum != e.Check {
+				t.Errorf("bit by bit: want %#x, got %#x", e.Check, sum)
+			}
+
+			p := &crcutil.Poly[uint64]{Word: am.Poly, Width: am.Width}
+			for i := 0; i < 20; i++ {
+				off := rnd.Intn(8 * len(data))
+				n := rnd.Intn(8*len(data) - off)
+				for _, order := range []crcutil.BitOrder{native, other} {
+					reg := am.Init
+					for j := off; j < off+n; j++ {
+						shift := j % 8
+						if order == crcutil.MSBitFirst {
+							shift = 7 - shift
+						}
+						reg = crcutil.UpdateBitwise(p, reg, uint64(data[j/8]>>shift&1), 1)
+					}
+					if am.RefOut {
+						reg = bits.Reverse64(reg) >> (64 - am.Width)
+					}
+					want := T(reg ^ am.XorOut)
+					if got := updateBits(m, data, order, off, n); got != want {
+						t.Errorf("bits %d+%d, order %d: want %#x, got %#x", off, n, order, want, got)
+					}
+				}
+			}
+		})
+	}
+}
+
+// updateBits returns the checksum of m over the bit ranges of p,
+// specified as pairs of offset and number of bits, processed using UpdateBits.
+func updateBits[T crcutil.Word](m *crcutil.Model[T], p []byte, order crcutil.BitOrder, ranges ...int) T {
+	inst := m.New()
+	for i := 0; i < len(ranges); i += 2 {
+		inst.UpdateBits(p, ranges[i], ranges[i+1], order)
+	}
+	return inst.Sum()
+}
@@ -0,0 +1,46 @@
+package crcutil
+
+import (
+	"encoding/binary"
+	"unsafe"
+)
+
+// UpdateWords adds a sequence of words to the crc of inst.
+// Each word is converted into bytes using the specified byte order,
+// like binary.BigEndian or binary.LittleEndian,
+// and the bits of each byte are processed in the specified bit order,
+// as in [Inst.UpdateBits].
+//
+// The resulting sequence of bits does not depend on whether the Model
+// processes bytes in normal or reflected form: binary.BigEndian and
+// MSBitFirst process each word most significant bit first,
+// binary.LittleEndian and LSBitFirst least significant bit first.
+// For a Model processing bytes in normal form, the former is equivalent
+// to calling Update with the big-endian representation of the words;
+// for a Model processing bytes in reflected form, the latter
+// to calling Update with their little-endian representation.
+func UpdateWords[T, D Word](inst *Inst[T], words []D, byteOrder binary.ByteOrder, bitOrder BitOrder) {
+	var buf [256]byte
+	size := int(unsafe.Sizeof(D(0)))
+	for len(words) != 0 {
+		n := len(buf) / size
+		if n > len(words) {
+			n = len(words)
+		}
+		for i, w := range words[:n] {
+			b := buf[i*size:]
+			switch size {
+			case 1:
+				b[0] = byte(w)
+			case 2:
+				byteOrder.PutUint16(b, uint16(w))
+			case 4:
+				byteOrder.PutUint32(b, uint32(w))
+			case 8:
+				byteOrder.PutUint64(b, uint64(w))
+			}
+		}
+		inst.UpdateBits(buf[:n*size], 0, 8*n*size, bitOrder)
+		words = words[n:]
+	}
+}
@@ -0,0 +1,101 @@
+package crcutil_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	"github.com/knieriem/crcutil/crc16"
+	"github.com/knieriem/crcutil/crc32"
+	"github.com/knieriem/crcutil/crc8"
+)
+
+// This example calculates the crc of a 16-bit measurement value,
+// as transmitted by Sensirion sensors, over the word value.
+func ExampleUpdateWords() {
+	inst := crc8.NRSC5.New()
+	crcutil.UpdateWords(inst, []uint16{0xbeef}, binary.BigEndian, crcutil.MSBitFirst)
+	fmt.Printf("%#02x\n", inst.Sum())
+	// Output: 0x92
+}
+
+// TestUpdateWords compares the results of UpdateWords with
+// checksums over the words converted into bytes, for models
+// processing bytes in reflected and normal form.
+func TestUpdateWords(t *testing.T) {
+	words := make([]uint32, 300)
+	for i := range words {
+		words[i] = uint32(i) * 0x9e3779b9
+	}
+
+	for _, tc := range []struct {
+		name      string
+		model     *crc32.Model
+		byteOrder binary.ByteOrder
+		bitOrder  crcutil.BitOrder
+		reflect   bool // reflect the bits of each byte
+	}{
+		{"ISO-HDLC/BigEndian/LSBitFirst", crc32.ISOHDLC, binary.BigEndian, crcutil.LSBitFirst, false},
+		{"ISO-HDLC/LittleEndian/LSBitFirst", crc32.ISOHDLC, binary.LittleEndian, crcutil.LSBitFirst, false},
+		{"ISO-HDLC/LittleEndian/MSBitFirst", crc32.ISOHDLC, binary.LittleEndian, crcutil.MSBitFirst, true},
+		{"BZIP2/BigEndian/MSBitFirst", crc32.BZIP2, binary.BigEndian, crcutil.MSBitFirst, false},
+		{"BZIP2/LittleEndian/MSBitFirst", crc32.BZIP2, binary.LittleEndian, crcutil.MSBitFirst, false},
+		{"BZIP2/BigEndian/LSBitFirst", crc32.BZIP2, binary.BigEndian, crcutil.LSBitFirst, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b := make([]byte, 4*len(words))
+			for i, w := range words {
+				tc.byteOrder.PutUint32(b[4*i:], w)
+			}
+			if tc.reflect {
+				for i := range b {
+					b[i] = bits.Reverse8(b[i])
+				}
+			}
+			want := tc.model.Checksum(b)
+
+			inst := tc.model.New()
+			crcutil.UpdateWords(inst, words, tc.byteOrder, tc.bitOrder)
+			if got := inst.Sum(); got != want {
+				t.Errorf("want %#x, got %#x", want, got)
+			}
+		})
+	}
+}
+
+// TestUpdateWordsValue verifies that the sequence of bits processed
+// by UpdateWords is independent of the bit order of the Model:
+// CRC-16/XMODEM processes bytes in normal form, CRC-16/KERMIT in
+// reflected form, using the same polynomial, both with zero initial value,
+// and no final XOR, so that their results are reflections of each other.
+func TestUpdateWordsValue(t *testing.T) {
+	words := []uint16{0x1234, 0xfedc, 0x0001}
+
+	for _, tc := range []struct {
+		name      string
+		byteOrder binary.ByteOrder
+		bitOrder  crcutil.BitOrder
+		want      uint16 // result of CRC-16/XMODEM
+	}{
+		{"BigEndian/MSBitFirst", binary.BigEndian, crcutil.MSBitFirst, 0x29ef},
+		{"LittleEndian/LSBitFirst", binary.LittleEndian, crcutil.LSBitFirst, 0xf0f9},
+		{"BigEndian/LSBitFirst", binary.BigEndian, crcutil.LSBitFirst, 0xd68a},
+		{"LittleEndian/MSBitFirst", binary.LittleEndian, crcutil.MSBitFirst, 0x45a7},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			normal := crc16.XMODEM.New()
+			crcutil.UpdateWords(normal, words, tc.byteOrder, tc.bitOrder)
+			if got := normal.Sum(); got != tc.want {
+				t.Errorf("XMODEM: want %#04x, got %#04x", tc.want, got)
+			}
+
+			reflected := crc16.Kermit.New()
+			crcutil.UpdateWords(reflected, words, tc.byteOrder, tc.bitOrder)
+			if got := bits.Reverse16(reflected.Sum()); got != tc.want {
+				t.Errorf("KERMIT: want reflected %#04x, got %#04x", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package crcutil
+
+import (
+	"bytes"
+)
+
+// Verify reports whether frame ends with a valid checksum
+// of the preceding data, stored as written by [Inst.AppendSum].
+// If the Model reflects input and output alike, the whole frame is processed,
+// and the resulting register is compared with the expected residue;
+// otherwise the checksum of the data is compared with the end of the frame.
+func (m *Model[T]) Verify(frame []byte) bool {
+	inst := m.New()
+	n := inst.sumLen()
+	if len(frame) < n {
+		return false
+	}
+	if m.reflectsIn() != m.reflectsOut() {
+		inst.Update(frame[:len(frame)-n])
+		var buf [8]byte
+		return bytes.Equal(inst.AppendSum(buf[:0]), frame[len(frame)-n:])
+	}
+	inst.Update(frame)
+	return inst.Valid()
+}
+
+// Valid reports whether the bytes added to the crc so far
+// form a codeword, i.e. end with the checksum of the preceding bytes,
+// as written by AppendSum of an instance created with the same options.
+// This allows a receiver to validate a frame while it is being received,
+// without knowing in advance where the checksum is located.
+//
+// Models that reflect the crc value differently than input bytes,
+// like CRC-12/UMTS, do not result in a constant residue;
+// in this case Valid always returns false, and [Model.Verify]
+// should be used instead.
+func (inst *Inst[T]) Valid() bool {
+	m := inst.model
+	if m.reflectsIn() != m.reflectsOut() {
+		return false
+	}
+	return inst.adjustCRC(inst.crc) == inst.validReg()
+}
+
+// validReg returns the content of the register, in processing
+// representation, after a codeword has been processed.
+// The residue is advanced by the number of unused bits
+// in the bytes written by AppendSum.
+func (inst *Inst[T]) validReg() T {
+	m := inst.model
+	var r T
+	if !inst.conf.appendSumSkipFinalXOR {
+		r = m.reflectOut(m.Residue())
+	}
+	if pad := 8*inst.sumLen() - m.Poly.Width; pad > 0 {
+		r = UpdateBitwise(m.procPoly(), r, T(0), pad)
+	}
+	return r
+}
+
+// sumLen returns the number of bytes written by AppendSum.
+func (inst *Inst[T]) sumLen() int {
+	var buf [8]byte
+	return len(inst.impl.Append(buf[:0], 0))
+}
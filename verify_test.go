@@ -0,0 +1,86 @@
+package crcutil_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/knieriem/crcutil"
+	_ "github.com/knieriem/crcutil/catalog"
+	"github.com/knieriem/crcutil/poly16"
+)
+
+// TestVerify checks, for all catalogued models, that frames
+// with an appended checksum are accepted by Verify and Valid,
+// and that corrupted frames are rejected.
+func TestVerify(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 100)
+	rnd.Read(data)
+
+	for _, e := range crcutil.Registered() {
+		m := e.AnyModel()
+		inst, err := m.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		inst.Update(data)
+		frame := inst.AppendSum(append([]byte(nil), data...))
+		if ok, _ := m.Verify(frame); !ok {
+			t.Errorf("%s: valid frame not accepted", e.Name)
+		}
+
+		inst.Reset()
+		inst.Update(frame)
+		if !inst.Valid() && m.RefIn == m.RefOut {
+			t.Errorf("%s: Valid: valid frame not accepted", e.Name)
+		}
+
+		frame[rnd.Intn(len(data))] ^= 1 << rnd.Intn(8)
+		if ok, _ := m.Verify(frame); ok {
+			t.Errorf("%s: corrupted frame accepted", e.Name)
+		}
+	}
+}
+
+// TestVerifyReciprocal checks that Verify and Valid accept frames
+// of models using a polynomial in reciprocal form.
+func TestVerifyReciprocal(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		poly *crcutil.Poly[uint16]
+	}{
+		{"reciprocal", poly16.CCITT.ReciprocalForm()},
+		{"reversed reciprocal", poly16.CCITT.ReversedForm().ReciprocalForm()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &crcutil.Model[uint16]{Poly: tc.poly, InitialInvert: true, FinalInvert: true}
+			inst := m.New()
+			inst.Update([]byte(crcutil.CheckInput))
+			frame := inst.AppendSum([]byte(crcutil.CheckInput))
+			if !m.Verify(frame) {
+				t.Error("valid frame not accepted")
+			}
+			inst = m.New()
+			inst.Update(frame)
+			if !inst.Valid() {
+				t.Error("Valid: valid frame not accepted")
+			}
+		})
+	}
+}
+
+func TestValidSkipFinalXOR(t *testing.T) {
+	m, ok := crcutil.LookupModel[uint32]("CRC-32/ISO-HDLC")
+	if !ok {
+		t.Fatal("model not registered")
+	}
+	inst := m.New(crcutil.AppendSumSkipFinalXOR())
+	inst.Update([]byte(crcutil.CheckInput))
+	frame := inst.AppendSum([]byte(crcutil.CheckInput))
+
+	inst = m.New(crcutil.AppendSumSkipFinalXOR())
+	inst.Update(frame)
+	if !inst.Valid() {
+		t.Error("valid frame not accepted")
+	}
+}